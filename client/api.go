@@ -0,0 +1,539 @@
+package client
+
+import (
+	"context"
+	"io"
+)
+
+// API is implemented by Client and lets callers depend on an interface,
+// e.g. to replace the client with a mock in tests
+type API interface {
+	CatalogAPI
+	StyleAPI
+	ResourceAPI
+	ServiceAPI
+	SecurityAPI
+	GwcAPI
+}
+
+var _ API = (*Client)(nil)
+
+// CatalogAPI gathers the methods managing workspaces, stores, feature types and layers
+type CatalogAPI interface {
+	GetWorkspaces() (workspaces []*Workspace, err error)
+	GetWorkspacesContext(ctx context.Context) (workspaces []*Workspace, err error)
+	GetWorkspace(name string) (workspace *Workspace, err error)
+	GetWorkspaceContext(ctx context.Context, name string) (workspace *Workspace, err error)
+	CreateWorkspace(workspace *Workspace, isDefault bool) (err error)
+	CreateWorkspaceContext(ctx context.Context, workspace *Workspace, isDefault bool) (err error)
+	UpdateWorkspace(name string, workspace *Workspace) (err error)
+	UpdateWorkspaceContext(ctx context.Context, name string, workspace *Workspace) (err error)
+	DeleteWorkspace(name string, recurse bool) (err error)
+	DeleteWorkspaceContext(ctx context.Context, name string, recurse bool) (err error)
+
+	GetNamespaces() (namespaces []*Namespace, err error)
+	GetNamespacesContext(ctx context.Context) (namespaces []*Namespace, err error)
+	GetNamespaceNames() (names []string, err error)
+	GetNamespaceNamesContext(ctx context.Context) (names []string, err error)
+	GetNamespace(prefix string) (namespace *Namespace, err error)
+	GetNamespaceContext(ctx context.Context, prefix string) (namespace *Namespace, err error)
+	CreateNamespace(namespace *Namespace) (err error)
+	CreateNamespaceContext(ctx context.Context, namespace *Namespace) (err error)
+	UpdateNamespace(prefix string, namespace *Namespace) (err error)
+	UpdateNamespaceContext(ctx context.Context, prefix string, namespace *Namespace) (err error)
+	DeleteNamespace(prefix string) (err error)
+	DeleteNamespaceContext(ctx context.Context, prefix string) (err error)
+	CreateWorkspaceWithNamespace(name, uri string, isolated, isDefault bool) (err error)
+	CreateWorkspaceWithNamespaceContext(ctx context.Context, name, uri string, isolated, isDefault bool) (err error)
+
+	GetDatastores(workspace string) (datastores []*Datastore, err error)
+	GetDatastoresContext(ctx context.Context, workspace string) (datastores []*Datastore, err error)
+	GetDatastoreNames(workspace string) (names []string, err error)
+	GetDatastoreNamesContext(ctx context.Context, workspace string) (names []string, err error)
+	GetDatastore(workspace, name string) (datastore *Datastore, err error)
+	GetDatastoreContext(ctx context.Context, workspace, name string) (datastore *Datastore, err error)
+	CreateDatastore(workspace string, datastore *Datastore) (err error)
+	CreateDatastoreContext(ctx context.Context, workspace string, datastore *Datastore) (err error)
+	UpdateDatastore(workspaceName, datastoreName string, datastore *Datastore) (err error)
+	UpdateDatastoreContext(ctx context.Context, workspaceName, datastoreName string, datastore *Datastore) (err error)
+	DeleteDatastore(workspaceName, datastoreName string, recurse bool) (err error)
+	DeleteDatastoreContext(ctx context.Context, workspaceName, datastoreName string, recurse bool) (err error)
+
+	GetFeatureTypes(workspace, datastore string) (featureTypes []*FeatureType, err error)
+	GetFeatureTypesContext(ctx context.Context, workspace, datastore string) (featureTypes []*FeatureType, err error)
+	GetFeatureTypeNames(workspace, datastore string) (names []string, err error)
+	GetFeatureTypeNamesContext(ctx context.Context, workspace, datastore string) (names []string, err error)
+	GetFeatureType(workspace, datastore, name string) (featureType *FeatureType, err error)
+	GetFeatureTypeContext(ctx context.Context, workspace, datastore, name string) (featureType *FeatureType, err error)
+	CreateFeatureType(workspace string, datastore string, featureType *FeatureType) (err error)
+	CreateFeatureTypeContext(ctx context.Context, workspace string, datastore string, featureType *FeatureType) (err error)
+	UpdateFeatureType(workspace, datastore, featureTypeName string, featureType *FeatureType, recalculateAttributes bool) (err error)
+	UpdateFeatureTypeContext(ctx context.Context, workspace, datastore, featureTypeName string, featureType *FeatureType, recalculateAttributes bool) (err error)
+	DeleteFeatureType(workspace, datastore, featureType string, recurse bool) (err error)
+	DeleteFeatureTypeContext(ctx context.Context, workspace, datastore, featureType string, recurse bool) (err error)
+
+	GetLayers(workspace string) (layers []*Layer, err error)
+	GetLayersContext(ctx context.Context, workspace string) (layers []*Layer, err error)
+	GetLayerNames(workspace string) (names []string, err error)
+	GetLayerNamesContext(ctx context.Context, workspace string) (names []string, err error)
+	GetLayer(workspace, name string) (layer *Layer, err error)
+	GetLayerContext(ctx context.Context, workspace, name string) (layer *Layer, err error)
+	UpdateLayer(workspace, layerName string, layer *Layer) (err error)
+	UpdateLayerContext(ctx context.Context, workspace, layerName string, layer *Layer) (err error)
+	DeleteLayer(workspace, layerName string, recurse bool) (err error)
+	DeleteLayerContext(ctx context.Context, workspace, layerName string, recurse bool) (err error)
+
+	GetGroups(workspace string) (layerGroups []*LayerGroup, err error)
+	GetGroupsContext(ctx context.Context, workspace string) (layerGroups []*LayerGroup, err error)
+	GetGroupNames(workspace string) (names []string, err error)
+	GetGroupNamesContext(ctx context.Context, workspace string) (names []string, err error)
+	GetGroup(workspace, name string) (layerGroup *LayerGroup, err error)
+	GetGroupContext(ctx context.Context, workspace, name string) (layerGroup *LayerGroup, err error)
+	CreateGroup(workspace string, layerGroup *LayerGroup) (err error)
+	CreateGroupContext(ctx context.Context, workspace string, layerGroup *LayerGroup) (err error)
+	UpdateGroup(workspace string, layerGroup *LayerGroup) (err error)
+	UpdateGroupContext(ctx context.Context, workspace string, layerGroup *LayerGroup) (err error)
+	DeleteGroup(workspace string, layerGroup string) (err error)
+	DeleteGroupContext(ctx context.Context, workspace string, layerGroup string) (err error)
+
+	GetCoverageStores(workspace string) (coverageStores []*CoverageStore, err error)
+	GetCoverageStoresContext(ctx context.Context, workspace string) (coverageStores []*CoverageStore, err error)
+	GetCoverageStoreNames(workspace string) (names []string, err error)
+	GetCoverageStoreNamesContext(ctx context.Context, workspace string) (names []string, err error)
+	GetCoverageStore(workspace, name string) (coverageStore *CoverageStore, err error)
+	GetCoverageStoreContext(ctx context.Context, workspace, name string) (coverageStore *CoverageStore, err error)
+	CreateCoverageStore(workspace string, coverageStore *CoverageStore) (err error)
+	CreateCoverageStoreContext(ctx context.Context, workspace string, coverageStore *CoverageStore) (err error)
+	UpdateCoverageStore(workspaceName, coverageStoreName string, coverageStore *CoverageStore) (err error)
+	UpdateCoverageStoreContext(ctx context.Context, workspaceName, coverageStoreName string, coverageStore *CoverageStore) (err error)
+	DeleteCoverageStore(workspaceName, coverageStoreName string, recurse bool, purge string) (err error)
+	DeleteCoverageStoreContext(ctx context.Context, workspaceName, coverageStoreName string, recurse bool, purge string) (err error)
+
+	GetCoverages(workspace, coverageStore string) (coverages []*Coverage, err error)
+	GetCoveragesContext(ctx context.Context, workspace, coverageStore string) (coverages []*Coverage, err error)
+	GetCoverageNames(workspace, coverageStore string) (names []string, err error)
+	GetCoverageNamesContext(ctx context.Context, workspace, coverageStore string) (names []string, err error)
+	GetAvailableCoverages(workspace, coverageStore string) (names []string, err error)
+	GetAvailableCoveragesContext(ctx context.Context, workspace, coverageStore string) (names []string, err error)
+	GetCoverage(workspace, coverageStore, name string) (coverage *Coverage, err error)
+	GetCoverageContext(ctx context.Context, workspace, coverageStore, name string) (coverage *Coverage, err error)
+	CreateCoverage(workspace, coverageStore string, coverage *Coverage) (err error)
+	CreateCoverageContext(ctx context.Context, workspace, coverageStore string, coverage *Coverage) (err error)
+	UpdateCoverage(workspace, coverageStore, coverageName string, coverage *Coverage, calculate ...string) (err error)
+	UpdateCoverageContext(ctx context.Context, workspace, coverageStore, coverageName string, coverage *Coverage, calculate ...string) (err error)
+	DeleteCoverage(workspace, coverageStore, coverage string, recurse bool) (err error)
+	DeleteCoverageContext(ctx context.Context, workspace, coverageStore, coverage string, recurse bool) (err error)
+
+	GetGranuleSchema(workspace, coverageStore, coverage string) (schema *GranuleSchema, err error)
+	GetGranuleSchemaContext(ctx context.Context, workspace, coverageStore, coverage string) (schema *GranuleSchema, err error)
+	GetGranules(workspace, coverageStore, coverage string, query *GranuleQuery) (granuleList []*Granule, err error)
+	GetGranulesContext(ctx context.Context, workspace, coverageStore, coverage string, query *GranuleQuery) (granuleList []*Granule, err error)
+	WalkGranules(workspace, coverageStore, coverage, filter string, pageSize int, fn func(granules []*Granule) error) (err error)
+	WalkGranulesContext(ctx context.Context, workspace, coverageStore, coverage, filter string, pageSize int, fn func(granules []*Granule) error) (err error)
+	GetGranule(workspace, coverageStore, coverage, granuleID string) (granule *Granule, err error)
+	GetGranuleContext(ctx context.Context, workspace, coverageStore, coverage, granuleID string) (granule *Granule, err error)
+	DeleteGranules(workspace, coverageStore, coverage, filter, purge string) (err error)
+	DeleteGranulesContext(ctx context.Context, workspace, coverageStore, coverage, filter, purge string) (err error)
+	DeleteGranule(workspace, coverageStore, coverage, granuleID, purge string) (err error)
+	DeleteGranuleContext(ctx context.Context, workspace, coverageStore, coverage, granuleID, purge string) (err error)
+	HarvestGranules(workspace, coverageStore string, data io.Reader, options *UploadOptions) (err error)
+	HarvestGranulesContext(ctx context.Context, workspace, coverageStore string, data io.Reader, options *UploadOptions) (err error)
+	HarvestGranulesFile(workspace, coverageStore, path string, options *UploadOptions) (err error)
+	HarvestGranulesFileContext(ctx context.Context, workspace, coverageStore, path string, options *UploadOptions) (err error)
+
+	UploadDatastore(workspace, datastore, format string, data io.Reader, options *UploadOptions) (err error)
+	UploadDatastoreContext(ctx context.Context, workspace, datastore, format string, data io.Reader, options *UploadOptions) (err error)
+	UploadDatastoreFile(workspace, datastore, format, path string, options *UploadOptions) (err error)
+	UploadDatastoreFileContext(ctx context.Context, workspace, datastore, format, path string, options *UploadOptions) (err error)
+	UploadCoverageStore(workspace, coverageStore, format string, data io.Reader, options *UploadOptions) (err error)
+	UploadCoverageStoreContext(ctx context.Context, workspace, coverageStore, format string, data io.Reader, options *UploadOptions) (err error)
+	UploadCoverageStoreFile(workspace, coverageStore, format, path string, options *UploadOptions) (err error)
+	UploadCoverageStoreFileContext(ctx context.Context, workspace, coverageStore, format, path string, options *UploadOptions) (err error)
+
+	GetWmsStores(workspace string) (wmsStores []*WmsStore, err error)
+	GetWmsStoresContext(ctx context.Context, workspace string) (wmsStores []*WmsStore, err error)
+	GetWmsStoreNames(workspace string) (names []string, err error)
+	GetWmsStoreNamesContext(ctx context.Context, workspace string) (names []string, err error)
+	GetWmsStore(workspace, name string) (wmsStore *WmsStore, err error)
+	GetWmsStoreContext(ctx context.Context, workspace, name string) (wmsStore *WmsStore, err error)
+	CreateWmStore(workspace string, wmsStore *WmsStore) (err error)
+	CreateWmStoreContext(ctx context.Context, workspace string, wmsStore *WmsStore) (err error)
+	UpdateWmsStore(workspaceName, wmsStoreName string, wmsStore *WmsStore) (err error)
+	UpdateWmsStoreContext(ctx context.Context, workspaceName, wmsStoreName string, wmsStore *WmsStore) (err error)
+	DeleteWmsStore(workspaceName, wmsStoreName string, recurse bool) (err error)
+	DeleteWmsStoreContext(ctx context.Context, workspaceName, wmsStoreName string, recurse bool) (err error)
+
+	GetWmsLayers(workspace, wmsstore string) (wmsLayers []*WmsLayer, err error)
+	GetWmsLayersContext(ctx context.Context, workspace, wmsstore string) (wmsLayers []*WmsLayer, err error)
+	GetWmsLayerNames(workspace, wmsstore string) (names []string, err error)
+	GetWmsLayerNamesContext(ctx context.Context, workspace, wmsstore string) (names []string, err error)
+	GetWmsLayer(workspace, wmsstore, name string) (wmsLayer *WmsLayer, err error)
+	GetWmsLayerContext(ctx context.Context, workspace, wmsstore, name string) (wmsLayer *WmsLayer, err error)
+	CreateWmsLayer(workspace string, wmsstore string, wmsLayer *WmsLayer) (err error)
+	CreateWmsLayerContext(ctx context.Context, workspace string, wmsstore string, wmsLayer *WmsLayer) (err error)
+	UpdateWmsLayer(workspace, wmsstore, wmsLayerName string, wmsLayer *WmsLayer) (err error)
+	UpdateWmsLayerContext(ctx context.Context, workspace, wmsstore, wmsLayerName string, wmsLayer *WmsLayer) (err error)
+	DeleteWmsLayer(workspace, wmsstore, wmsLayerName string, recurse bool) (err error)
+	DeleteWmsLayerContext(ctx context.Context, workspace, wmsstore, wmsLayerName string, recurse bool) (err error)
+
+	GetWmtsStores(workspace string) (wmtsStores []*WmtsStore, err error)
+	GetWmtsStoresContext(ctx context.Context, workspace string) (wmtsStores []*WmtsStore, err error)
+	GetWmtsStoreNames(workspace string) (names []string, err error)
+	GetWmtsStoreNamesContext(ctx context.Context, workspace string) (names []string, err error)
+	GetWmtsStore(workspace, name string) (wmtsStore *WmtsStore, err error)
+	GetWmtsStoreContext(ctx context.Context, workspace, name string) (wmtsStore *WmtsStore, err error)
+	CreateWmtStore(workspace string, wmtsStore *WmtsStore) (err error)
+	CreateWmtStoreContext(ctx context.Context, workspace string, wmtsStore *WmtsStore) (err error)
+	UpdateWmtsStore(workspaceName, wmtsStoreName string, wmtsStore *WmtsStore) (err error)
+	UpdateWmtsStoreContext(ctx context.Context, workspaceName, wmtsStoreName string, wmtsStore *WmtsStore) (err error)
+	DeleteWmtsStore(workspaceName, wmtsStoreName string, recurse bool) (err error)
+	DeleteWmtsStoreContext(ctx context.Context, workspaceName, wmtsStoreName string, recurse bool) (err error)
+
+	GetWmtsLayers(workspace, wmtsstore string) (wmtsLayers []*WmtsLayer, err error)
+	GetWmtsLayersContext(ctx context.Context, workspace, wmtsstore string) (wmtsLayers []*WmtsLayer, err error)
+	GetWmtsLayerNames(workspace, wmtsstore string) (names []string, err error)
+	GetWmtsLayerNamesContext(ctx context.Context, workspace, wmtsstore string) (names []string, err error)
+	GetWmtsLayer(workspace, wmtsstore, name string) (wmtsLayer *WmtsLayer, err error)
+	GetWmtsLayerContext(ctx context.Context, workspace, wmtsstore, name string) (wmtsLayer *WmtsLayer, err error)
+	CreateWmtsLayer(workspace string, wmtsstore string, wmtsLayer *WmtsLayer) (err error)
+	CreateWmtsLayerContext(ctx context.Context, workspace string, wmtsstore string, wmtsLayer *WmtsLayer) (err error)
+	UpdateWmtsLayer(workspace, wmtsstore, wmtsLayerName string, wmtsLayer *WmtsLayer) (err error)
+	UpdateWmtsLayerContext(ctx context.Context, workspace, wmtsstore, wmtsLayerName string, wmtsLayer *WmtsLayer) (err error)
+	DeleteWmtsLayer(workspace, wmtsstore, wmtsLayerName string, recurse bool) (err error)
+	DeleteWmtsLayerContext(ctx context.Context, workspace, wmtsstore, wmtsLayerName string, recurse bool) (err error)
+}
+
+// StyleAPI gathers the methods managing styles
+type StyleAPI interface {
+	GetStyles(workspace string) (styles []*Style, err error)
+	GetStylesContext(ctx context.Context, workspace string) (styles []*Style, err error)
+	GetStyleNames(workspace string) (names []string, err error)
+	GetStyleNamesContext(ctx context.Context, workspace string) (names []string, err error)
+	GetStyle(workspace, name string) (style *Style, err error)
+	GetStyleContext(ctx context.Context, workspace, name string) (style *Style, err error)
+	GetStyleFile(workspace, name string, styleFormat string, formatVersion string) (styleFile string, err error)
+	GetStyleFileContext(ctx context.Context, workspace, name string, styleFormat string, formatVersion string) (styleFile string, err error)
+	CreateStyle(workspace string, style *Style) (err error)
+	CreateStyleContext(ctx context.Context, workspace string, style *Style) (err error)
+	UpdateStyle(workspace string, style *Style, styleDefinition string) (err error)
+	UpdateStyleContext(ctx context.Context, workspace string, style *Style, styleDefinition string) (err error)
+	UpdateStyleContent(workspace string, style *Style, styleDefinition string) (err error)
+	UpdateStyleContentContext(ctx context.Context, workspace string, style *Style, styleDefinition string) (err error)
+	DeleteStyle(workspace string, style string, purge bool, recurse bool) (err error)
+	DeleteStyleContext(ctx context.Context, workspace string, style string, purge bool, recurse bool) (err error)
+}
+
+// ResourceAPI gathers the methods managing the files of the data directory
+type ResourceAPI interface {
+	GetResource(pathToResource string, resourceExtension string) (resourceContent string, err error)
+	GetResourceContext(ctx context.Context, pathToResource string, resourceExtension string) (resourceContent string, err error)
+	CreateResource(pathToResource string, resourceExtension string, resourceContent string) (err error)
+	CreateResourceContext(ctx context.Context, pathToResource string, resourceExtension string, resourceContent string) (err error)
+	UpdateResource(pathToResource string, resourceExtension string, resourceContent string) (err error)
+	UpdateResourceContext(ctx context.Context, pathToResource string, resourceExtension string, resourceContent string) (err error)
+	DeleteResource(resource string) (err error)
+	DeleteResourceContext(ctx context.Context, resource string) (err error)
+}
+
+// ServiceAPI gathers the methods managing the global, workspace and OWS services settings
+type ServiceAPI interface {
+	GetSettings() (settings *GlobalSettings, err error)
+	GetSettingsContext(ctx context.Context) (settings *GlobalSettings, err error)
+	UpdateSettings(settings *GlobalSettings) (err error)
+	UpdateSettingsContext(ctx context.Context, settings *GlobalSettings) (err error)
+	GetContact() (contact *Contact, err error)
+	GetContactContext(ctx context.Context) (contact *Contact, err error)
+	UpdateContact(contact *Contact) (err error)
+	UpdateContactContext(ctx context.Context, contact *Contact) (err error)
+	GetWorkspaceSettings(workspace string) (settings *Settings, err error)
+	GetWorkspaceSettingsContext(ctx context.Context, workspace string) (settings *Settings, err error)
+	UpdateWorkspaceSettings(workspace string, settings *Settings) (err error)
+	UpdateWorkspaceSettingsContext(ctx context.Context, workspace string, settings *Settings) (err error)
+	DeleteWorkspaceSettings(workspace string) (err error)
+	DeleteWorkspaceSettingsContext(ctx context.Context, workspace string) (err error)
+
+	GetServiceWMS(workspace string) (serviceWms *ServiceWms, err error)
+	GetServiceWMSContext(ctx context.Context, workspace string) (serviceWms *ServiceWms, err error)
+	UpdateServiceWMS(workspace string, serviceWms *ServiceWms) (err error)
+	UpdateServiceWMSContext(ctx context.Context, workspace string, serviceWms *ServiceWms) (err error)
+	DeleteWorkspaceServiceWms(workspace string) (err error)
+	DeleteWorkspaceServiceWmsContext(ctx context.Context, workspace string) (err error)
+
+	GetServiceWFS(workspace string) (serviceWfs *ServiceWfs, err error)
+	GetServiceWFSContext(ctx context.Context, workspace string) (serviceWfs *ServiceWfs, err error)
+	UpdateServiceWFS(workspace string, serviceWfs *ServiceWfs) (err error)
+	UpdateServiceWFSContext(ctx context.Context, workspace string, serviceWfs *ServiceWfs) (err error)
+	DeleteWorkspaceServiceWfs(workspace string) (err error)
+	DeleteWorkspaceServiceWfsContext(ctx context.Context, workspace string) (err error)
+
+	GetServiceWCS(workspace string) (serviceWcs *ServiceWcs, err error)
+	GetServiceWCSContext(ctx context.Context, workspace string) (serviceWcs *ServiceWcs, err error)
+	UpdateServiceWCS(workspace string, serviceWcs *ServiceWcs) (err error)
+	UpdateServiceWCSContext(ctx context.Context, workspace string, serviceWcs *ServiceWcs) (err error)
+	DeleteWorkspaceServiceWcs(workspace string) (err error)
+	DeleteWorkspaceServiceWcsContext(ctx context.Context, workspace string) (err error)
+
+	GetServiceWMTS(workspace string) (serviceWmts *ServiceWmts, err error)
+	GetServiceWMTSContext(ctx context.Context, workspace string) (serviceWmts *ServiceWmts, err error)
+	UpdateServiceWMTS(workspace string, serviceWmts *ServiceWmts) (err error)
+	UpdateServiceWMTSContext(ctx context.Context, workspace string, serviceWmts *ServiceWmts) (err error)
+	DeleteWorkspaceServiceWmts(workspace string) (err error)
+	DeleteWorkspaceServiceWmtsContext(ctx context.Context, workspace string) (err error)
+
+	GetServiceWPS(workspace string) (serviceWps *ServiceWps, err error)
+	GetServiceWPSContext(ctx context.Context, workspace string) (serviceWps *ServiceWps, err error)
+	UpdateServiceWPS(workspace string, serviceWps *ServiceWps) (err error)
+	UpdateServiceWPSContext(ctx context.Context, workspace string, serviceWps *ServiceWps) (err error)
+	DeleteWorkspaceServiceWps(workspace string) (err error)
+	DeleteWorkspaceServiceWpsContext(ctx context.Context, workspace string) (err error)
+}
+
+// SecurityAPI gathers the methods managing users, groups, roles, access rules, authentication, security services, passwords and URL checks
+type SecurityAPI interface {
+	GetUsers(serviceName string) (users Users, err error)
+	GetUsersContext(ctx context.Context, serviceName string) (users Users, err error)
+	GetUser(serviceName, userName string) (user *User, err error)
+	GetUserContext(ctx context.Context, serviceName, userName string) (user *User, err error)
+	CreateUser(service string, user *User) (err error)
+	CreateUserContext(ctx context.Context, service string, user *User) (err error)
+	UpdateUser(service, userName string, user *User) (err error)
+	UpdateUserContext(ctx context.Context, service, userName string, user *User) (err error)
+	DeleteUser(service, userName string) (err error)
+	DeleteUserContext(ctx context.Context, service, userName string) (err error)
+
+	GetUserGroups(service string) (groups []string, err error)
+	GetUserGroupsContext(ctx context.Context, service string) (groups []string, err error)
+	GetUserGroup(service, groupName string) (group string, err error)
+	GetUserGroupContext(ctx context.Context, service, groupName string) (group string, err error)
+	CreateUserGroup(service, groupName string) (err error)
+	CreateUserGroupContext(ctx context.Context, service, groupName string) (err error)
+	DeleteUserGroup(service, groupName string) (err error)
+	DeleteUserGroupContext(ctx context.Context, service, groupName string) (err error)
+	GetUserGroupMembers(service, groupName string) (users Users, err error)
+	GetUserGroupMembersContext(ctx context.Context, service, groupName string) (users Users, err error)
+	GetUserMemberships(service, userName string) (groups []string, err error)
+	GetUserMembershipsContext(ctx context.Context, service, userName string) (groups []string, err error)
+	AddUserToGroup(service, userName, groupName string) (err error)
+	AddUserToGroupContext(ctx context.Context, service, userName, groupName string) (err error)
+	RemoveUserFromGroup(service, userName, groupName string) (err error)
+	RemoveUserFromGroupContext(ctx context.Context, service, userName, groupName string) (err error)
+
+	GetRoles(service string) (roles []string, err error)
+	GetRolesContext(ctx context.Context, service string) (roles []string, err error)
+	GetUserRoles(service, userName string) (roles []string, err error)
+	GetUserRolesContext(ctx context.Context, service, userName string) (roles []string, err error)
+	GetGroupRoles(service, groupName string) (roles []string, err error)
+	GetGroupRolesContext(ctx context.Context, service, groupName string) (roles []string, err error)
+	CreateRole(service, role string) (err error)
+	CreateRoleContext(ctx context.Context, service, role string) (err error)
+	DeleteRole(service, role string) (err error)
+	DeleteRoleContext(ctx context.Context, service, role string) (err error)
+	AssignRoleToUser(service, role, userName string) (err error)
+	AssignRoleToUserContext(ctx context.Context, service, role, userName string) (err error)
+	UnassignRoleFromUser(service, role, userName string) (err error)
+	UnassignRoleFromUserContext(ctx context.Context, service, role, userName string) (err error)
+	AssignRoleToGroup(service, role, groupName string) (err error)
+	AssignRoleToGroupContext(ctx context.Context, service, role, groupName string) (err error)
+	UnassignRoleFromGroup(service, role, groupName string) (err error)
+	UnassignRoleFromGroupContext(ctx context.Context, service, role, groupName string) (err error)
+	GetRoleParents(service string) (parents map[string]string, err error)
+	GetRoleParentsContext(ctx context.Context, service string) (parents map[string]string, err error)
+	SetRoleParent(service, role, parent string) (err error)
+	SetRoleParentContext(ctx context.Context, service, role, parent string) (err error)
+
+	GetLayerRules() (rules LayerRules, err error)
+	GetLayerRulesContext(ctx context.Context) (rules LayerRules, err error)
+	GetLayerRule(ruleDef string) (rule *LayerRule, err error)
+	GetLayerRuleContext(ctx context.Context, ruleDef string) (rule *LayerRule, err error)
+	CreateLayerRule(rule *LayerRule) (err error)
+	CreateLayerRuleContext(ctx context.Context, rule *LayerRule) (err error)
+	UpdateLayerRule(rule *LayerRule) (err error)
+	UpdateLayerRuleContext(ctx context.Context, rule *LayerRule) (err error)
+	DeleteLayerRule(ruleDefinition string) (err error)
+	DeleteLayerRuleContext(ctx context.Context, ruleDefinition string) (err error)
+
+	GrantLayerRule(resource, role string) (err error)
+	GrantLayerRuleContext(ctx context.Context, resource, role string) (err error)
+	RevokeLayerRule(resource, role string) (err error)
+	RevokeLayerRuleContext(ctx context.Context, resource, role string) (err error)
+
+	GetServiceRules() (rules LayerRules, err error)
+	GetServiceRulesContext(ctx context.Context) (rules LayerRules, err error)
+	GetServiceRule(resource string) (rule *LayerRule, err error)
+	GetServiceRuleContext(ctx context.Context, resource string) (rule *LayerRule, err error)
+	CreateServiceRule(rule *LayerRule) (err error)
+	CreateServiceRuleContext(ctx context.Context, rule *LayerRule) (err error)
+	UpdateServiceRule(rule *LayerRule) (err error)
+	UpdateServiceRuleContext(ctx context.Context, rule *LayerRule) (err error)
+	DeleteServiceRule(resource string) (err error)
+	DeleteServiceRuleContext(ctx context.Context, resource string) (err error)
+
+	GetRestRules() (rules LayerRules, err error)
+	GetRestRulesContext(ctx context.Context) (rules LayerRules, err error)
+	GetRestRule(resource string) (rule *LayerRule, err error)
+	GetRestRuleContext(ctx context.Context, resource string) (rule *LayerRule, err error)
+	CreateRestRule(rule *LayerRule) (err error)
+	CreateRestRuleContext(ctx context.Context, rule *LayerRule) (err error)
+	UpdateRestRule(rule *LayerRule) (err error)
+	UpdateRestRuleContext(ctx context.Context, rule *LayerRule) (err error)
+	DeleteRestRule(resource string) (err error)
+	DeleteRestRuleContext(ctx context.Context, resource string) (err error)
+
+	GetCatalogMode() (mode string, err error)
+	GetCatalogModeContext(ctx context.Context) (mode string, err error)
+	UpdateCatalogMode(mode string) (err error)
+	UpdateCatalogModeContext(ctx context.Context, mode string) (err error)
+
+	GetAuthProviderNames() (names []string, err error)
+	GetAuthProviderNamesContext(ctx context.Context) (names []string, err error)
+	GetAuthProvider(name string) (provider *AuthProvider, err error)
+	GetAuthProviderContext(ctx context.Context, name string) (provider *AuthProvider, err error)
+	CreateAuthProvider(provider *AuthProvider) (err error)
+	CreateAuthProviderContext(ctx context.Context, provider *AuthProvider) (err error)
+	UpdateAuthProvider(name string, provider *AuthProvider) (err error)
+	UpdateAuthProviderContext(ctx context.Context, name string, provider *AuthProvider) (err error)
+	DeleteAuthProvider(name string) (err error)
+	DeleteAuthProviderContext(ctx context.Context, name string) (err error)
+	UpdateAuthProviderOrder(names []string) (err error)
+	UpdateAuthProviderOrderContext(ctx context.Context, names []string) (err error)
+
+	GetAuthFilterNames() (names []string, err error)
+	GetAuthFilterNamesContext(ctx context.Context) (names []string, err error)
+	GetAuthFilter(name string) (filter *AuthFilter, err error)
+	GetAuthFilterContext(ctx context.Context, name string) (filter *AuthFilter, err error)
+	CreateAuthFilter(filter *AuthFilter) (err error)
+	CreateAuthFilterContext(ctx context.Context, filter *AuthFilter) (err error)
+	UpdateAuthFilter(name string, filter *AuthFilter) (err error)
+	UpdateAuthFilterContext(ctx context.Context, name string, filter *AuthFilter) (err error)
+	DeleteAuthFilter(name string) (err error)
+	DeleteAuthFilterContext(ctx context.Context, name string) (err error)
+
+	GetFilterChains() (chains []*FilterChain, err error)
+	GetFilterChainsContext(ctx context.Context) (chains []*FilterChain, err error)
+	GetFilterChain(name string) (chain *FilterChain, err error)
+	GetFilterChainContext(ctx context.Context, name string) (chain *FilterChain, err error)
+	CreateFilterChain(chain *FilterChain) (err error)
+	CreateFilterChainContext(ctx context.Context, chain *FilterChain) (err error)
+	UpdateFilterChain(name string, chain *FilterChain) (err error)
+	UpdateFilterChainContext(ctx context.Context, name string, chain *FilterChain) (err error)
+	DeleteFilterChain(name string) (err error)
+	DeleteFilterChainContext(ctx context.Context, name string) (err error)
+	UpdateFilterChainOrder(names []string) (err error)
+	UpdateFilterChainOrderContext(ctx context.Context, names []string) (err error)
+
+	GetUserGroupServiceNames() (names []string, err error)
+	GetUserGroupServiceNamesContext(ctx context.Context) (names []string, err error)
+	GetUserGroupService(name string) (service *UserGroupService, err error)
+	GetUserGroupServiceContext(ctx context.Context, name string) (service *UserGroupService, err error)
+	CreateUserGroupService(service *UserGroupService) (err error)
+	CreateUserGroupServiceContext(ctx context.Context, service *UserGroupService) (err error)
+	UpdateUserGroupService(name string, service *UserGroupService) (err error)
+	UpdateUserGroupServiceContext(ctx context.Context, name string, service *UserGroupService) (err error)
+	DeleteUserGroupService(name string) (err error)
+	DeleteUserGroupServiceContext(ctx context.Context, name string) (err error)
+
+	GetPasswordPolicyNames() (names []string, err error)
+	GetPasswordPolicyNamesContext(ctx context.Context) (names []string, err error)
+	GetPasswordPolicy(name string) (policy *PasswordPolicy, err error)
+	GetPasswordPolicyContext(ctx context.Context, name string) (policy *PasswordPolicy, err error)
+	CreatePasswordPolicy(policy *PasswordPolicy) (err error)
+	CreatePasswordPolicyContext(ctx context.Context, policy *PasswordPolicy) (err error)
+	UpdatePasswordPolicy(name string, policy *PasswordPolicy) (err error)
+	UpdatePasswordPolicyContext(ctx context.Context, name string, policy *PasswordPolicy) (err error)
+	DeletePasswordPolicy(name string) (err error)
+	DeletePasswordPolicyContext(ctx context.Context, name string) (err error)
+
+	GetRoleServiceNames() (names []string, err error)
+	GetRoleServiceNamesContext(ctx context.Context) (names []string, err error)
+	GetRoleService(name string) (service *RoleService, err error)
+	GetRoleServiceContext(ctx context.Context, name string) (service *RoleService, err error)
+	CreateRoleService(service *RoleService) (err error)
+	CreateRoleServiceContext(ctx context.Context, service *RoleService) (err error)
+	UpdateRoleService(name string, service *RoleService) (err error)
+	UpdateRoleServiceContext(ctx context.Context, name string, service *RoleService) (err error)
+	DeleteRoleService(name string) (err error)
+	DeleteRoleServiceContext(ctx context.Context, name string) (err error)
+	GetActiveRoleService() (name string, err error)
+	GetActiveRoleServiceContext(ctx context.Context) (name string, err error)
+	SetActiveRoleService(name string) (err error)
+	SetActiveRoleServiceContext(ctx context.Context, name string) (err error)
+
+	GetMasterPassword() (password string, err error)
+	GetMasterPasswordContext(ctx context.Context) (password string, err error)
+	UpdateMasterPassword(oldPassword, newPassword string) (err error)
+	UpdateMasterPasswordContext(ctx context.Context, oldPassword, newPassword string) (err error)
+	ChangePassword(newPassword string) (err error)
+	ChangePasswordContext(ctx context.Context, newPassword string) (err error)
+	RotatePassword(newPassword string) (err error)
+	RotatePasswordContext(ctx context.Context, newPassword string) (err error)
+
+	GetUrlChecks() (urlChecks []*RegexUrlCheck, err error)
+	GetUrlChecksContext(ctx context.Context) (urlChecks []*RegexUrlCheck, err error)
+	GetUrlCheckNames() (names []string, err error)
+	GetUrlCheckNamesContext(ctx context.Context) (names []string, err error)
+	GetRegExUrlCheck(urlCheckName string) (regExUrlCheck *RegexUrlCheck, err error)
+	GetRegExUrlCheckContext(ctx context.Context, urlCheckName string) (regExUrlCheck *RegexUrlCheck, err error)
+	CreateRegExUrlCheck(checkName string, checkDefinition *RegexUrlCheck) (err error)
+	CreateRegExUrlCheckContext(ctx context.Context, checkName string, checkDefinition *RegexUrlCheck) (err error)
+	UpdateRegExUrlCheck(checkName string, checkDefinition *RegexUrlCheck) (err error)
+	UpdateRegExUrlCheckContext(ctx context.Context, checkName string, checkDefinition *RegexUrlCheck) (err error)
+	DeleteUrlCheck(checkName string) (err error)
+	DeleteUrlCheckContext(ctx context.Context, checkName string) (err error)
+}
+
+// GwcAPI gathers the methods managing GeoWebCache
+type GwcAPI interface {
+	GetGwcGsLayer(name string) (layer *GwcGsLayer, err error)
+	GetGwcGsLayerContext(ctx context.Context, name string) (layer *GwcGsLayer, err error)
+	CreateGwcGsLayer(layerName string, layer *GwcGsLayer) (err error)
+	CreateGwcGsLayerContext(ctx context.Context, layerName string, layer *GwcGsLayer) (err error)
+	UpdateGwcGsLayer(layerName string, layer *GwcGsLayer) (err error)
+	UpdateGwcGsLayerContext(ctx context.Context, layerName string, layer *GwcGsLayer) (err error)
+	DeleteGwcGsLayer(layerName string) (err error)
+	DeleteGwcGsLayerContext(ctx context.Context, layerName string) (err error)
+
+	GetGwcWMSLayer(name string) (layer *GwcWmsLayer, err error)
+	GetGwcWMSLayerContext(ctx context.Context, name string) (layer *GwcWmsLayer, err error)
+	CreateGwcWmsLayer(layerName string, layer *GwcWmsLayer) (err error)
+	CreateGwcWmsLayerContext(ctx context.Context, layerName string, layer *GwcWmsLayer) (err error)
+	UpdateGwcWmsLayer(layerName string, layer *GwcWmsLayer) (err error)
+	UpdateGwcWmsLayerContext(ctx context.Context, layerName string, layer *GwcWmsLayer) (err error)
+	DeleteGwcWmsLayer(layerName string) (err error)
+	DeleteGwcWmsLayerContext(ctx context.Context, layerName string) (err error)
+
+	GetGridsets() (gridsets []*Gridset, err error)
+	GetGridsetsContext(ctx context.Context) (gridsets []*Gridset, err error)
+	GetGridsetNames() (names []string, err error)
+	GetGridsetNamesContext(ctx context.Context) (names []string, err error)
+	GetGridset(name string) (gridset *Gridset, err error)
+	GetGridsetContext(ctx context.Context, name string) (gridset *Gridset, err error)
+	CreateGridset(gridsetName string, gridset *Gridset) (err error)
+	CreateGridsetContext(ctx context.Context, gridsetName string, gridset *Gridset) (err error)
+	UpdateGridset(gridsetName string, gridset *Gridset) (err error)
+	UpdateGridsetContext(ctx context.Context, gridsetName string, gridset *Gridset) (err error)
+	DeleteGridset(gridsetName string) (err error)
+	DeleteGridsetContext(ctx context.Context, gridsetName string) (err error)
+
+	GetBlobstoreFile(name string) (blobstore *BlobstoreFile, err error)
+	GetBlobstoreFileContext(ctx context.Context, name string) (blobstore *BlobstoreFile, err error)
+	CreateBlobstoreFile(blobstoreName string, blobstore *BlobstoreFile) (err error)
+	CreateBlobstoreFileContext(ctx context.Context, blobstoreName string, blobstore *BlobstoreFile) (err error)
+	UpdateBlobstoreFile(blobstoreName string, blobstore *BlobstoreFile) (err error)
+	UpdateBlobstoreFileContext(ctx context.Context, blobstoreName string, blobstore *BlobstoreFile) (err error)
+	DeleteBlobstoreFile(blobstoreName string) (err error)
+	DeleteBlobstoreFileContext(ctx context.Context, blobstoreName string) (err error)
+
+	GetBlobstoreS3(name string) (blobstore *BlobstoreS3, err error)
+	GetBlobstoreS3Context(ctx context.Context, name string) (blobstore *BlobstoreS3, err error)
+	CreateBlobstoreS3(blobstoreName string, blobstore *BlobstoreS3) (err error)
+	CreateBlobstoreS3Context(ctx context.Context, blobstoreName string, blobstore *BlobstoreS3) (err error)
+	UpdateBlobstoreS3(blobstoreName string, blobstore *BlobstoreS3) (err error)
+	UpdateBlobstoreS3Context(ctx context.Context, blobstoreName string, blobstore *BlobstoreS3) (err error)
+	DeleteBlobstoreS3(blobstoreName string) (err error)
+	DeleteBlobstoreS3Context(ctx context.Context, blobstoreName string) (err error)
+
+	GetGwcQuotaConfiguration() (gwcQuotCfg *GwcQuotaConfiguration, err error)
+	GetGwcQuotaConfigurationContext(ctx context.Context) (gwcQuotCfg *GwcQuotaConfiguration, err error)
+	UpdateGwcQuotaConfiguration(gwcQuotCfg *GwcQuotaConfiguration) (err error)
+	UpdateGwcQuotaConfigurationContext(ctx context.Context, gwcQuotCfg *GwcQuotaConfiguration) (err error)
+}
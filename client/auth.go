@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Authenticator adds credentials to the requests sent to GeoServer
+type Authenticator interface {
+	Authenticate(request *http.Request) error
+}
+
+// BasicAuth authenticates requests with a username and a password
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+// Authenticate implements Authenticator
+func (a *BasicAuth) Authenticate(request *http.Request) error {
+	request.SetBasicAuth(a.Username, a.Password)
+	return nil
+}
+
+// BearerToken authenticates requests with a static bearer token, for instance
+// when GeoServer sits behind an OIDC proxy
+type BearerToken struct {
+	Token string
+}
+
+// Authenticate implements Authenticator
+func (a *BearerToken) Authenticate(request *http.Request) error {
+	request.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+// APIKeyLocation tells where an API key is sent
+type APIKeyLocation int
+
+const (
+	// APIKeyInHeader sends the API key as a request header
+	APIKeyInHeader APIKeyLocation = iota
+	// APIKeyInQuery sends the API key as a query parameter, as expected by
+	// the authkey extension
+	APIKeyInQuery
+)
+
+// APIKey authenticates requests with a key sent as a header or a query parameter
+type APIKey struct {
+	Name  string
+	Value string
+	In    APIKeyLocation
+}
+
+// Authenticate implements Authenticator
+func (a *APIKey) Authenticate(request *http.Request) error {
+	switch a.In {
+	case APIKeyInHeader:
+		request.Header.Set(a.Name, a.Value)
+	case APIKeyInQuery:
+		query := request.URL.Query()
+		query.Set(a.Name, a.Value)
+		request.URL.RawQuery = query.Encode()
+	default:
+		return fmt.Errorf("unknown API key location: %d", a.In)
+	}
+	return nil
+}
+
+// ClientCredentials authenticates requests with a bearer token obtained with
+// the OAuth2 client credentials grant. The token is cached and refreshed
+// before it expires.
+type ClientCredentials struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+
+	// HTTPClient is used to request tokens, http.DefaultClient when nil
+	HTTPClient *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// tokenExpiryDelta is how long before its expiry a token is refreshed
+const tokenExpiryDelta = 30 * time.Second
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// Authenticate implements Authenticator
+func (a *ClientCredentials) Authenticate(request *http.Request) error {
+	token, err := a.Token(request.Context())
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Token returns a valid access token, requesting a new one if needed
+func (a *ClientCredentials) Token(ctx context.Context) (token string, err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && (a.expiry.IsZero() || time.Now().Add(tokenExpiryDelta).Before(a.expiry)) {
+		return a.token, nil
+	}
+
+	form := url.Values{
+		"grant_type": {"client_credentials"},
+	}
+	if len(a.Scopes) > 0 {
+		form.Set("scope", strings.Join(a.Scopes, " "))
+	}
+
+	tokenRequest, err := http.NewRequestWithContext(ctx, "POST", a.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return
+	}
+	tokenRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	tokenRequest.Header.Set("Accept", "application/json")
+	tokenRequest.SetBasicAuth(url.QueryEscape(a.ClientID), url.QueryEscape(a.ClientSecret))
+
+	httpClient := a.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	response, err := httpClient.Do(tokenRequest)
+	if err != nil {
+		return
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != 200 {
+		err = fmt.Errorf("cannot get token from %s: %d - %s", a.TokenURL, response.StatusCode, response.Status)
+		return
+	}
+
+	var data tokenResponse
+	if err = json.NewDecoder(response.Body).Decode(&data); err != nil {
+		return
+	}
+	if data.AccessToken == "" {
+		err = fmt.Errorf("cannot get token from %s: empty access token", a.TokenURL)
+		return
+	}
+
+	a.token = data.AccessToken
+	a.expiry = time.Time{}
+	if data.ExpiresIn > 0 {
+		a.expiry = time.Now().Add(time.Duration(data.ExpiresIn) * time.Second)
+	}
+
+	return a.token, nil
+}
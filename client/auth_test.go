@@ -0,0 +1,217 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLegacyBasicAuth(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", username)
+		assert.Equal(t, "", password)
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<workspaces></workspaces>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		Username:   "admin",
+		HTTPClient: &http.Client{},
+	}
+
+	_, err := cli.GetWorkspaces()
+
+	assert.Nil(t, err)
+}
+
+func TestBasicAuth(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", username)
+		assert.Equal(t, "geoserver", password)
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<workspaces></workspaces>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		Username:   "ignored",
+		HTTPClient: &http.Client{},
+		Authenticator: &BasicAuth{
+			Username: "admin",
+			Password: "geoserver",
+		},
+	}
+
+	_, err := cli.GetWorkspaces()
+
+	assert.Nil(t, err)
+}
+
+func TestBearerToken(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer s3cr3t", r.Header.Get("Authorization"))
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<workspaces></workspaces>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:           testServer.URL,
+		HTTPClient:    &http.Client{},
+		Authenticator: &BearerToken{Token: "s3cr3t"},
+	}
+
+	_, err := cli.GetWorkspaces()
+
+	assert.Nil(t, err)
+}
+
+func TestAPIKeyInQuery(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/workspaces/topp", r.URL.Path)
+		assert.Equal(t, "abcd", r.URL.Query().Get("authkey"))
+		assert.Equal(t, "true", r.URL.Query().Get("recurse"))
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+		Authenticator: &APIKey{
+			Name:  "authkey",
+			Value: "abcd",
+			In:    APIKeyInQuery,
+		},
+	}
+
+	err := cli.DeleteWorkspace("topp", true)
+
+	assert.Nil(t, err)
+}
+
+func TestAPIKeyInHeader(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "abcd", r.Header.Get("X-Api-Key"))
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<workspaces></workspaces>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+		Authenticator: &APIKey{
+			Name:  "X-Api-Key",
+			Value: "abcd",
+		},
+	}
+
+	_, err := cli.GetWorkspaces()
+
+	assert.Nil(t, err)
+}
+
+func TestClientCredentials(t *testing.T) {
+	tokenRequests := 0
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		assert.Equal(t, "POST", r.Method)
+		clientID, clientSecret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "geoserver-client", clientID)
+		assert.Equal(t, "secret", clientSecret)
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "read write", r.PostForm.Get("scope"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"access_token":"t0k3n","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer t0k3n", r.Header.Get("Authorization"))
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<workspaces></workspaces>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+		Authenticator: &ClientCredentials{
+			TokenURL:     tokenServer.URL,
+			ClientID:     "geoserver-client",
+			ClientSecret: "secret",
+			Scopes:       []string{"read", "write"},
+		},
+	}
+
+	_, err := cli.GetWorkspaces()
+	assert.Nil(t, err)
+	_, err = cli.GetWorkspaces()
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, tokenRequests)
+}
+
+func TestClientCredentialsRefresh(t *testing.T) {
+	tokenRequests := 0
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+
+		w.WriteHeader(200)
+		w.Write([]byte(`{"access_token":"t0k3n","expires_in":10}`))
+	}))
+	defer tokenServer.Close()
+
+	auth := &ClientCredentials{
+		TokenURL: tokenServer.URL,
+	}
+
+	request := httptest.NewRequest("GET", "/workspaces", nil)
+	assert.Nil(t, auth.Authenticate(request))
+	assert.Nil(t, auth.Authenticate(request))
+
+	// Tokens expiring within tokenExpiryDelta are always refreshed
+	assert.Equal(t, 2, tokenRequests)
+}
+
+func TestClientCredentialsError(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(401)
+	}))
+	defer tokenServer.Close()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:           testServer.URL,
+		HTTPClient:    &http.Client{},
+		Authenticator: &ClientCredentials{TokenURL: tokenServer.URL},
+	}
+
+	_, err := cli.GetWorkspaces()
+
+	assert.Error(t, err)
+}
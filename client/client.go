@@ -1,21 +1,55 @@
 package client
 
 import (
+	"context"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 )
 
 // Client contains information to connect to a Geoserver instance
 type Client struct {
-	URL      string
+	// URL is the base URL of the REST API, e.g. http://localhost:8080/geoserver/rest
+	URL string
+	// GwcURL is the base URL of the GeoWebCache REST API, e.g.
+	// http://localhost:8080/geoserver/gwc/rest. GeoWebCache requests are sent
+	// to URL when it is empty.
+	GwcURL string
+
 	Username string
+	// Password must not be changed directly while requests are being sent,
+	// RotatePassword swaps it safely
 	Password string
 
+	// credentialsMutex guards Username and Password during a rotation
+	credentialsMutex sync.RWMutex
+
 	HTTPClient *http.Client
+
+	// UserAgent is sent as the User-Agent header when not empty
+	UserAgent string
+
+	// Authenticator adds credentials to the requests. When it is nil, basic
+	// authentication is used with Username and Password.
+	Authenticator Authenticator
+
+	// RetryPolicy decides whether failed requests are sent again. Requests
+	// are never retried when it is nil.
+	RetryPolicy RetryPolicy
+
+	// Interceptors wrap every call, the first one being the outermost
+	Interceptors []Interceptor
+
+	// MaxConcurrency is the number of items fetched at the same time when
+	// listing resources. They are fetched one after the other when it is
+	// lower than 2.
+	MaxConcurrency int
 }
 
 // NewClient returns a Client that connect to a Geoserver instance
-func NewClient(url, username, password string) (client *Client, err error) {
+func NewClient(url, username, password string, options ...Option) (client *Client, err error) {
 	client = &Client{
 		URL:      url,
 		Username: username,
@@ -24,44 +58,199 @@ func NewClient(url, username, password string) (client *Client, err error) {
 		HTTPClient: &http.Client{},
 	}
 
+	for _, option := range options {
+		if err = option(client); err != nil {
+			return nil, err
+		}
+	}
+
 	return
 }
 
-func (c *Client) doRequest(method, path string, data io.Reader) (statusCode int, body string, err error) {
-	return c.doTypedRequest(method, path, data, "application/xml")
+// NewClientFromRoot returns a Client that connect to the Geoserver instance
+// installed at rootURL, e.g. http://localhost:8080/geoserver. Both the REST
+// API and the GeoWebCache REST API can be used with it.
+func NewClientFromRoot(rootURL, username, password string, options ...Option) (client *Client, err error) {
+	rootURL = strings.TrimRight(rootURL, "/")
+
+	client, err = NewClient(rootURL+"/rest", username, password, options...)
+	if err != nil {
+		return
+	}
+	client.GwcURL = rootURL + "/gwc/rest"
+
+	return
+}
+
+func (c *Client) doRequest(ctx context.Context, method, path string, data io.Reader) (statusCode int, body string, err error) {
+	return c.doTypedRequest(ctx, method, path, data, "application/xml")
+}
+
+func (c *Client) doTypedRequest(ctx context.Context, method, path string, data io.Reader, contentType string) (statusCode int, body string, err error) {
+	return c.doFullyTypedRequest(ctx, method, path, data, contentType, contentType)
+}
+
+func (c *Client) doFullyTypedRequest(ctx context.Context, method, path string, data io.Reader, contentType string, acceptType string) (statusCode int, body string, err error) {
+	return c.doCall(ctx, &Call{
+		API:         APIRest,
+		Method:      method,
+		Path:        path,
+		Body:        data,
+		ContentType: contentType,
+		Accept:      acceptType,
+	})
 }
 
-func (c *Client) doTypedRequest(method, path string, data io.Reader, contentType string) (statusCode int, body string, err error) {
-	return c.doFullyTypedRequest(method, path, data, contentType, contentType)
+// doGwcRequest sends a request to the GeoWebCache REST API
+func (c *Client) doGwcRequest(ctx context.Context, method, path string, data io.Reader) (statusCode int, body string, err error) {
+	return c.doCall(ctx, &Call{
+		API:         APIGwc,
+		Method:      method,
+		Path:        path,
+		Body:        data,
+		ContentType: "application/xml",
+		Accept:      "application/xml",
+	})
 }
 
-func (c *Client) doFullyTypedRequest(method, path string, data io.Reader, contentType string, acceptType string) (statusCode int, body string, err error) {
-	request, err := http.NewRequest(method, c.URL+path, data)
+// doCall runs the call through the interceptors before sending it
+func (c *Client) doCall(ctx context.Context, call *Call) (statusCode int, body string, err error) {
+	call.Resource = resourceOf(call.Path)
+
+	handler := c.roundTrip
+	for i := len(c.Interceptors) - 1; i >= 0; i-- {
+		interceptor, next := c.Interceptors[i], handler
+		handler = func(ctx context.Context, call *Call) (*Result, error) {
+			return interceptor(ctx, call, next)
+		}
+	}
+
+	result, err := handler(ctx, call)
+	if err != nil {
+		return
+	}
+
+	return result.StatusCode, result.Body, nil
+}
+
+// roundTrip sends the call to GeoServer and reads the response
+func (c *Client) roundTrip(ctx context.Context, call *Call) (result *Result, err error) {
+	baseURL := c.URL
+	if call.API == APIGwc && c.GwcURL != "" {
+		baseURL = c.GwcURL
+	}
+
+	request, err := http.NewRequestWithContext(ctx, call.Method, baseURL+call.Path, call.Body)
 	if err != nil {
 		return
 	}
 	request.Header.Set("Accept-Encoding", "identity")
-	if contentType != "" {
-		request.Header.Set("Content-Type", contentType)
+	if call.ContentType != "" {
+		request.Header.Set("Content-Type", call.ContentType)
+	}
+	if call.Accept != "" {
+		request.Header.Set("Accept", call.Accept)
 	}
-	if acceptType != "" {
-		request.Header.Set("Accept", acceptType)
+	if c.UserAgent != "" {
+		request.Header.Set("User-Agent", c.UserAgent)
 	}
-	if c.Username != "" && c.Password != "" {
-		request.SetBasicAuth(c.Username, c.Password)
+	// Seekable bodies, such as files, can be rewound to be sent again, from
+	// where they were when given. They are not closed once sent, which would
+	// prevent rewinding them.
+	if seeker, ok := call.Body.(io.ReadSeeker); ok && request.GetBody == nil {
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+
+		request.Body = io.NopCloser(seeker)
+		request.GetBody = func() (io.ReadCloser, error) {
+			if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+				return nil, err
+			}
+			return io.NopCloser(seeker), nil
+		}
 	}
-	response, err := c.HTTPClient.Do(request)
+
+	response, err := c.send(ctx, request)
 	if err != nil {
 		return
 	}
-	statusCode = response.StatusCode
 
 	defer response.Body.Close()
 	rawBody, err := io.ReadAll(response.Body)
 	if err != nil {
 		return
 	}
-	body = string(rawBody)
 
-	return
+	return &Result{
+		StatusCode: response.StatusCode,
+		Body:       string(rawBody),
+	}, nil
+}
+
+// send sends the request, as many times as the retry policy allows
+func (c *Client) send(ctx context.Context, request *http.Request) (response *http.Response, err error) {
+	for attempt := 1; ; attempt++ {
+		attemptRequest := request
+		if attempt > 1 {
+			attemptRequest = request.Clone(ctx)
+			if request.Body != nil && request.Body != http.NoBody {
+				if attemptRequest.Body, err = request.GetBody(); err != nil {
+					return
+				}
+			}
+		}
+
+		if err = c.authenticate(attemptRequest); err != nil {
+			return
+		}
+
+		response, err = c.HTTPClient.Do(attemptRequest)
+		if c.RetryPolicy == nil || !canResend(request) {
+			return
+		}
+
+		delay, retry := c.RetryPolicy.Retry(attempt, attemptRequest, response, err)
+		if !retry {
+			return
+		}
+
+		if response != nil {
+			io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// authenticate adds the credentials to the request
+func (c *Client) authenticate(request *http.Request) error {
+	if c.Authenticator != nil {
+		return c.Authenticator.Authenticate(request)
+	}
+
+	current, ok := request.Context().Value(credentialsKey{}).(credentials)
+	if !ok {
+		c.credentialsMutex.RLock()
+		current = credentials{username: c.Username, password: c.Password}
+		c.credentialsMutex.RUnlock()
+	}
+
+	if current.username != "" {
+		request.SetBasicAuth(current.username, current.password)
+	}
+	return nil
+}
+
+// canResend tells whether the body of the request can be sent again
+func canResend(request *http.Request) bool {
+	return request.Body == nil || request.Body == http.NoBody || request.GetBody != nil
 }
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextCanceled(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	workspaces, err := cli.GetWorkspacesContext(ctx)
+
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Nil(t, workspaces)
+}
+
+func TestContextDeadlineExceeded(t *testing.T) {
+	release := make(chan struct{})
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer testServer.Close()
+	defer close(release)
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := cli.DeleteWorkspaceContext(ctx, "topp", true)
+
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestNewClientFromRoot(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/geoserver/rest/layers/sf":
+			w.WriteHeader(200)
+			w.Write([]byte(`<layer><name>sf</name></layer>`))
+		case "/geoserver/gwc/rest/layers/sf":
+			w.WriteHeader(200)
+			w.Write([]byte(`<GeoServerLayer><name>sf</name><enabled>true</enabled></GeoServerLayer>`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+	defer testServer.Close()
+
+	cli, err := NewClientFromRoot(testServer.URL+"/geoserver/", "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, testServer.URL+"/geoserver/rest", cli.URL)
+	assert.Equal(t, testServer.URL+"/geoserver/gwc/rest", cli.GwcURL)
+
+	layer, err := cli.GetLayer("", "sf")
+	assert.Nil(t, err)
+	assert.Equal(t, "sf", layer.Name)
+
+	gwcLayer, err := cli.GetGwcGsLayer("sf")
+	assert.Nil(t, err)
+	assert.Equal(t, "sf", gwcLayer.Name)
+	assert.True(t, gwcLayer.Enabled)
+}
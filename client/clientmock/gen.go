@@ -0,0 +1,197 @@
+//go:build ignore
+
+// This program generates mock_gen.go from the interfaces declared in
+// client/api.go. It is run by go generate.
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+type method struct {
+	name    string
+	params  []*ast.Field
+	results []*ast.Field
+}
+
+func main() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "../api.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var methods []method
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			iface, ok := spec.(*ast.TypeSpec).Type.(*ast.InterfaceType)
+			if !ok {
+				continue
+			}
+			for _, field := range iface.Methods.List {
+				fn, ok := field.Type.(*ast.FuncType)
+				if !ok {
+					// Embedded interface, its methods are declared elsewhere
+					continue
+				}
+				methods = append(methods, method{
+					name:    field.Names[0].Name,
+					params:  fieldList(fn.Params),
+					results: fieldList(fn.Results),
+				})
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by gen.go; DO NOT EDIT.
+
+package clientmock
+
+import (
+	"sync"
+`)
+	// The standard packages used by the signatures
+	for _, spec := range file.Imports {
+		buf.WriteString("\t" + spec.Path.Value + "\n")
+	}
+	buf.WriteString(`
+	"github.com/camptocamp/go-geoserver/client"
+)
+
+var _ client.API = (*Mock)(nil)
+
+// Mock implements client.API without any HTTP call. Every call is recorded,
+// then handed to the matching function field when set. Methods without a
+// function return zero values.
+type Mock struct {
+	mu    sync.Mutex
+	calls []Call
+
+`)
+	for _, m := range methods {
+		buf.WriteString("\t" + m.name + "Func func(" + fields(fset, m.params, false) + ") (" + fields(fset, m.results, false) + ")\n")
+	}
+	buf.WriteString("}\n")
+
+	for _, m := range methods {
+		var names []string
+		for _, param := range m.params {
+			for _, name := range param.Names {
+				names = append(names, name.Name)
+			}
+		}
+		args := strings.Join(names, ", ")
+		callArgs := args
+		if len(m.params) > 0 {
+			if _, ok := m.params[len(m.params)-1].Type.(*ast.Ellipsis); ok {
+				callArgs += "..."
+			}
+		}
+
+		buf.WriteString("\n// " + m.name + " records the call and runs " + m.name + "Func when set\n")
+		buf.WriteString("func (m *Mock) " + m.name + "(" + fields(fset, m.params, true) + ") (" + fields(fset, m.results, true) + ") {\n")
+		if args == "" {
+			buf.WriteString("\tm.record(\"" + m.name + "\")\n")
+		} else {
+			buf.WriteString("\tm.record(\"" + m.name + "\", " + args + ")\n")
+		}
+		buf.WriteString("\tif m." + m.name + "Func != nil {\n")
+		buf.WriteString("\t\treturn m." + m.name + "Func(" + callArgs + ")\n")
+		buf.WriteString("\t}\n\treturn\n}\n")
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("mock_gen.go", source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func fieldList(list *ast.FieldList) []*ast.Field {
+	if list == nil {
+		return nil
+	}
+
+	return list.List
+}
+
+// fields prints a parameter or result list, qualifying the types of the
+// client package. Names are kept when named is set.
+func fields(fset *token.FileSet, list []*ast.Field, named bool) string {
+	var parts []string
+	for _, field := range list {
+		typ := qualify(fset, field.Type)
+		if !named || len(field.Names) == 0 {
+			count := max(len(field.Names), 1)
+			for i := 0; i < count; i++ {
+				parts = append(parts, typ)
+			}
+			continue
+		}
+
+		var names []string
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+		parts = append(parts, strings.Join(names, ", ")+" "+typ)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// qualify prints a type expression, prefixing the exported identifiers with
+// the client package
+func qualify(fset *token.FileSet, expr ast.Expr) string {
+	var rewrite func(ast.Expr) ast.Expr
+	rewrite = func(expr ast.Expr) ast.Expr {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			if ast.IsExported(e.Name) {
+				return &ast.SelectorExpr{X: ast.NewIdent("client"), Sel: ast.NewIdent(e.Name)}
+			}
+		case *ast.StarExpr:
+			return &ast.StarExpr{X: rewrite(e.X)}
+		case *ast.ArrayType:
+			return &ast.ArrayType{Len: e.Len, Elt: rewrite(e.Elt)}
+		case *ast.MapType:
+			return &ast.MapType{Key: rewrite(e.Key), Value: rewrite(e.Value)}
+		case *ast.Ellipsis:
+			return &ast.Ellipsis{Elt: rewrite(e.Elt)}
+		case *ast.FuncType:
+			rewriteFields := func(list *ast.FieldList) *ast.FieldList {
+				if list == nil {
+					return nil
+				}
+				rewritten := &ast.FieldList{}
+				for _, field := range list.List {
+					rewritten.List = append(rewritten.List, &ast.Field{Names: field.Names, Type: rewrite(field.Type)})
+				}
+				return rewritten
+			}
+			return &ast.FuncType{Params: rewriteFields(e.Params), Results: rewriteFields(e.Results)}
+		}
+		return expr
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, rewrite(expr)); err != nil {
+		log.Fatal(err)
+	}
+
+	return buf.String()
+}
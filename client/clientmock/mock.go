@@ -0,0 +1,59 @@
+// Package clientmock provides a mock of the client API, to test code using
+// the GeoServer client without any HTTP server.
+//
+// Behaviours are given through the function fields of Mock, and the calls
+// received are recorded:
+//
+//	mock := &clientmock.Mock{
+//		GetWorkspaceFunc: func(name string) (*client.Workspace, error) {
+//			return &client.Workspace{Name: name}, nil
+//		},
+//	}
+//	...
+//	calls := mock.CallsTo("CreateWorkspace")
+package clientmock
+
+//go:generate go run gen.go
+
+// Call is a call received by the mock
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// Calls returns all the calls received, in order
+func (m *Mock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns the calls received by the given method, in order
+func (m *Mock) CallsTo(method string) (calls []Call) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, call := range m.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return
+}
+
+// Reset forgets the calls received so far
+func (m *Mock) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = nil
+}
+
+func (m *Mock) record(method string, args ...any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
@@ -0,0 +1,65 @@
+package clientmock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/camptocamp/go-geoserver/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// createWorkspace stands for code depending on the catalog API
+func createWorkspace(catalog client.CatalogAPI, name string) error {
+	if _, err := catalog.GetWorkspace(name); err == nil {
+		return nil
+	}
+
+	return catalog.CreateWorkspace(&client.Workspace{Name: name}, false)
+}
+
+func TestMockRecordsCalls(t *testing.T) {
+	mock := &Mock{
+		GetWorkspaceFunc: func(name string) (*client.Workspace, error) {
+			return nil, client.ErrNotFound
+		},
+	}
+
+	err := createWorkspace(mock, "topp")
+	assert.Nil(t, err)
+
+	assert.Equal(t, []Call{
+		{Method: "GetWorkspace", Args: []any{"topp"}},
+		{Method: "CreateWorkspace", Args: []any{&client.Workspace{Name: "topp"}, false}},
+	}, mock.Calls())
+	assert.Len(t, mock.CallsTo("CreateWorkspace"), 1)
+
+	mock.Reset()
+	assert.Empty(t, mock.Calls())
+}
+
+func TestMockDefaultsToZeroValues(t *testing.T) {
+	mock := &Mock{}
+
+	workspace, err := mock.GetWorkspaceContext(context.Background(), "topp")
+	assert.Nil(t, workspace)
+	assert.Nil(t, err)
+
+	layers, err := mock.GetLayers("topp")
+	assert.Nil(t, layers)
+	assert.Nil(t, err)
+}
+
+func TestMockReturnsErrors(t *testing.T) {
+	expected := errors.New("boom")
+	mock := &Mock{
+		DeleteLayerFunc: func(workspace, layerName string, recurse bool) error {
+			return expected
+		},
+	}
+
+	var gs client.API = mock
+	err := gs.DeleteLayer("topp", "roads", true)
+	assert.Equal(t, expected, err)
+	assert.Equal(t, []any{"topp", "roads", true}, mock.CallsTo("DeleteLayer")[0].Args)
+}
@@ -0,0 +1,429 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// CoverageMetadata is a metadata for a coverage
+type CoverageMetadata struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",innerxml"`
+}
+
+// CoverageKeywords is a list of keywords of a coverage
+type CoverageKeywords struct {
+	Keywords []string `xml:"string"`
+}
+
+// CoverageStrings is a list of values of a coverage, e.g. its supported
+// formats
+type CoverageStrings struct {
+	List []string `xml:"string"`
+}
+
+// CoverageGridTransform is the affine transform from grid to world coordinates
+type CoverageGridTransform struct {
+	ScaleX     float64 `xml:"scaleX"`
+	ScaleY     float64 `xml:"scaleY"`
+	ShearX     float64 `xml:"shearX"`
+	ShearY     float64 `xml:"shearY"`
+	TranslateX float64 `xml:"translateX"`
+	TranslateY float64 `xml:"translateY"`
+}
+
+// CoverageGrid is the grid geometry of a coverage. Low and High are the
+// space separated grid coordinates of the range, e.g. "0 0" and "634 477".
+type CoverageGrid struct {
+	Dimension int                    `xml:"dimension,attr,omitempty"`
+	Low       string                 `xml:"range>low"`
+	High      string                 `xml:"range>high"`
+	Transform *CoverageGridTransform `xml:"transform,omitempty"`
+	CRS       string                 `xml:"crs,omitempty"`
+}
+
+// CoverageDimensionRange is the range of values of a dimension
+type CoverageDimensionRange struct {
+	Min float64 `xml:"min"`
+	Max float64 `xml:"max"`
+}
+
+// CoverageDimension describes a band of a coverage
+type CoverageDimension struct {
+	Name          string                  `xml:"name"`
+	Description   string                  `xml:"description,omitempty"`
+	Range         *CoverageDimensionRange `xml:"range,omitempty"`
+	NullValues    []float64               `xml:"nullValues>double,omitempty"`
+	Unit          string                  `xml:"unit,omitempty"`
+	DimensionType string                  `xml:"dimensionType>name,omitempty"`
+}
+
+// CoverageDimensions is the list of the bands of a coverage
+type CoverageDimensions struct {
+	List []*CoverageDimension `xml:"coverageDimension"`
+}
+
+// CoverageParameter is a read parameter of a coverage, e.g. the band
+// selection or the footprint behavior of a mosaic
+type CoverageParameter struct {
+	Key   string
+	Value string
+}
+
+// coverageParameterEntry is the XML form of a CoverageParameter, the key and
+// the value being two string elements
+type coverageParameterEntry struct {
+	Strings []string `xml:"string"`
+}
+
+// MarshalXML writes the parameter as a pair of string elements
+func (p CoverageParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(coverageParameterEntry{Strings: []string{p.Key, p.Value}}, start)
+}
+
+// UnmarshalXML reads the parameter from a pair of string elements
+func (p *CoverageParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var entry coverageParameterEntry
+	if err := d.DecodeElement(&entry, &start); err != nil {
+		return err
+	}
+
+	if len(entry.Strings) > 0 {
+		p.Key = entry.Strings[0]
+	}
+	if len(entry.Strings) > 1 {
+		p.Value = entry.Strings[1]
+	}
+
+	return nil
+}
+
+// Coverages is a list of Coverage
+type Coverages struct {
+	XMLName xml.Name    `xml:"coverages"`
+	List    []*Coverage `xml:"coverage"`
+}
+
+// AvailableCoverages is the list of the coverages of a store not published yet
+type AvailableCoverages struct {
+	XMLName xml.Name `xml:"list"`
+	List    []string `xml:"coverageName"`
+}
+
+// Coverage is a Geoserver object publishing the data of a coverage store
+type Coverage struct {
+	XMLName                    xml.Name             `xml:"coverage"`
+	Name                       string               `xml:"name"`
+	NativeName                 string               `xml:"nativeName,omitempty"`
+	NativeCoverageName         string               `xml:"nativeCoverageName,omitempty"`
+	Title                      string               `xml:"title,omitempty"`
+	Description                string               `xml:"description,omitempty"`
+	Abstract                   string               `xml:"abstract,omitempty"`
+	Keywords                   *CoverageKeywords    `xml:"keywords,omitempty"`
+	NativeCRS                  *FeatureTypeCRS      `xml:"nativeCRS,omitempty"`
+	SRS                        string               `xml:"srs,omitempty"`
+	NativeBoundingBox          *BoundingBox         `xml:"nativeBoundingBox,omitempty"`
+	LatLonBoundingBox          *BoundingBox         `xml:"latLonBoundingBox,omitempty"`
+	ProjectionPolicy           string               `xml:"projectionPolicy,omitempty"`
+	Enabled                    bool                 `xml:"enabled"`
+	Metadata                   []*CoverageMetadata  `xml:"metadata>entry,omitempty"`
+	Store                      *Resource            `xml:"store,omitempty"`
+	NativeFormat               string               `xml:"nativeFormat,omitempty"`
+	Grid                       *CoverageGrid        `xml:"grid,omitempty"`
+	SupportedFormats           *CoverageStrings     `xml:"supportedFormats,omitempty"`
+	InterpolationMethods       *CoverageStrings     `xml:"interpolationMethods,omitempty"`
+	DefaultInterpolationMethod string               `xml:"defaultInterpolationMethod,omitempty"`
+	Dimensions                 *CoverageDimensions  `xml:"dimensions,omitempty"`
+	RequestSRS                 *CoverageStrings     `xml:"requestSRS,omitempty"`
+	ResponseSRS                *CoverageStrings     `xml:"responseSRS,omitempty"`
+	Parameters                 []*CoverageParameter `xml:"parameters>entry,omitempty"`
+}
+
+// coveragesEndpoint returns the endpoint of the coverages of a workspace, or
+// of a coverage store when given
+func coveragesEndpoint(workspace, coverageStore string) string {
+	if coverageStore == "" {
+		return fmt.Sprintf("/workspaces/%s/coverages", workspace)
+	}
+
+	return fmt.Sprintf("/workspaces/%s/coveragestores/%s/coverages", workspace, coverageStore)
+}
+
+// GetCoverages returns all the coverages
+func (c *Client) GetCoverages(workspace, coverageStore string) (coverages []*Coverage, err error) {
+	return c.GetCoveragesContext(context.Background(), workspace, coverageStore)
+}
+
+// GetCoveragesContext is like GetCoverages but carries ctx down to the HTTP requests
+func (c *Client) GetCoveragesContext(ctx context.Context, workspace, coverageStore string) (coverages []*Coverage, err error) {
+	names, err := c.GetCoverageNamesContext(ctx, workspace, coverageStore)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*Coverage, error) {
+		return c.GetCoverageContext(ctx, workspace, coverageStore, name)
+	})
+}
+
+// GetCoverageNames returns the names of the coverages, without fetching them
+func (c *Client) GetCoverageNames(workspace, coverageStore string) (names []string, err error) {
+	return c.GetCoverageNamesContext(context.Background(), workspace, coverageStore)
+}
+
+// GetCoverageNamesContext is like GetCoverageNames but carries ctx down to the HTTP requests
+func (c *Client) GetCoverageNamesContext(ctx context.Context, workspace, coverageStore string) (names []string, err error) {
+	if workspace == "" {
+		err = fmt.Errorf("workspace cannot be null")
+		return
+	}
+
+	endpoint := coveragesEndpoint(workspace, coverageStore)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data Coverages
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return names, err
+	}
+
+	for _, coverageRef := range data.List {
+		names = append(names, coverageRef.Name)
+	}
+
+	return
+}
+
+// GetAvailableCoverages returns the names of the coverages of a store which
+// are not published yet
+func (c *Client) GetAvailableCoverages(workspace, coverageStore string) (names []string, err error) {
+	return c.GetAvailableCoveragesContext(context.Background(), workspace, coverageStore)
+}
+
+// GetAvailableCoveragesContext is like GetAvailableCoverages but carries ctx down to the HTTP requests
+func (c *Client) GetAvailableCoveragesContext(ctx context.Context, workspace, coverageStore string) (names []string, err error) {
+	if workspace == "" || coverageStore == "" {
+		err = fmt.Errorf("workspace and coverage store cannot be null")
+		return
+	}
+
+	endpoint := coveragesEndpoint(workspace, coverageStore) + "?list=available"
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data AvailableCoverages
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return names, err
+	}
+
+	names = data.List
+
+	return
+}
+
+// GetCoverage return a single coverage based on its name
+func (c *Client) GetCoverage(workspace, coverageStore, name string) (coverage *Coverage, err error) {
+	return c.GetCoverageContext(context.Background(), workspace, coverageStore, name)
+}
+
+// GetCoverageContext is like GetCoverage but carries ctx down to the HTTP requests
+func (c *Client) GetCoverageContext(ctx context.Context, workspace, coverageStore, name string) (coverage *Coverage, err error) {
+	if workspace == "" {
+		err = fmt.Errorf("workspace cannot be null")
+		return
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", coveragesEndpoint(workspace, coverageStore), name)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data Coverage
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return coverage, err
+	}
+
+	coverage = &data
+
+	return
+}
+
+// CreateCoverage publishes a coverage of a coverage store. Only the name and
+// the native name are required, GeoServer computing the rest from the data.
+func (c *Client) CreateCoverage(workspace, coverageStore string, coverage *Coverage) (err error) {
+	return c.CreateCoverageContext(context.Background(), workspace, coverageStore, coverage)
+}
+
+// CreateCoverageContext is like CreateCoverage but carries ctx down to the HTTP requests
+func (c *Client) CreateCoverageContext(ctx context.Context, workspace, coverageStore string, coverage *Coverage) (err error) {
+	if workspace == "" || coverageStore == "" {
+		err = fmt.Errorf("workspace and coverage store cannot be null")
+		return
+	}
+
+	coverage.XMLName = xml.Name{
+		Local: "coverage",
+	}
+	payload, err := xml.Marshal(coverage)
+	if err != nil {
+		return
+	}
+
+	endpoint := coveragesEndpoint(workspace, coverageStore)
+	statusCode, body, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("POST", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 201:
+		return
+	default:
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// UpdateCoverage updates a coverage. calculate lists the fields GeoServer
+// should compute again, among "nativebbox", "latlonbbox" and "dimensions".
+func (c *Client) UpdateCoverage(workspace, coverageStore, coverageName string, coverage *Coverage, calculate ...string) (err error) {
+	return c.UpdateCoverageContext(context.Background(), workspace, coverageStore, coverageName, coverage, calculate...)
+}
+
+// UpdateCoverageContext is like UpdateCoverage but carries ctx down to the HTTP requests
+func (c *Client) UpdateCoverageContext(ctx context.Context, workspace, coverageStore, coverageName string, coverage *Coverage, calculate ...string) (err error) {
+	if workspace == "" {
+		err = fmt.Errorf("workspace cannot be null")
+		return
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", coveragesEndpoint(workspace, coverageStore), coverageName)
+	if len(calculate) > 0 {
+		endpoint = endpoint + "?calculate=" + strings.Join(calculate, ",")
+	}
+
+	coverage.XMLName = xml.Name{
+		Local: "coverage",
+	}
+	payload, err := xml.Marshal(coverage)
+	if err != nil {
+		return
+	}
+
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// DeleteCoverage deletes a coverage
+func (c *Client) DeleteCoverage(workspace, coverageStore, coverage string, recurse bool) (err error) {
+	return c.DeleteCoverageContext(context.Background(), workspace, coverageStore, coverage, recurse)
+}
+
+// DeleteCoverageContext is like DeleteCoverage but carries ctx down to the HTTP requests
+func (c *Client) DeleteCoverageContext(ctx context.Context, workspace, coverageStore, coverage string, recurse bool) (err error) {
+	if workspace == "" {
+		err = fmt.Errorf("workspace cannot be null")
+		return
+	}
+
+	endpoint := fmt.Sprintf("%s/%s?recurse=%t", coveragesEndpoint(workspace, coverageStore), coverage, recurse)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 403:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
+		return
+	case 404:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
+		return
+	}
+}
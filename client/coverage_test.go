@@ -0,0 +1,311 @@
+package client
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCoverageSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/workspaces/sf/coveragestores/sfdem/coverages/sfdem")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<coverage>
+			<name>sfdem</name>
+			<nativeName>sfdem</nativeName>
+			<namespace>
+				<name>sf</name>
+			</namespace>
+			<title>Spearfish elevation</title>
+			<keywords>
+				<string>WCS</string>
+				<string>GeoTIFF</string>
+			</keywords>
+			<nativeCRS class="projected">EPSG:26713</nativeCRS>
+			<srs>EPSG:26713</srs>
+			<nativeBoundingBox>
+				<minx>589980.0</minx>
+				<maxx>609000.0</maxx>
+				<miny>4913700.0</miny>
+				<maxy>4928010.0</maxy>
+				<crs class="projected">EPSG:26713</crs>
+			</nativeBoundingBox>
+			<projectionPolicy>REPROJECT_TO_DECLARED</projectionPolicy>
+			<enabled>true</enabled>
+			<store class="coverageStore">
+				<name>sf:sfdem</name>
+			</store>
+			<nativeFormat>GeoTIFF</nativeFormat>
+			<grid dimension="2">
+				<range>
+					<low>0 0</low>
+					<high>634 477</high>
+				</range>
+				<transform>
+					<scaleX>30.0</scaleX>
+					<scaleY>-30.0</scaleY>
+					<shearX>0.0</shearX>
+					<shearY>0.0</shearY>
+					<translateX>589995.0</translateX>
+					<translateY>4927995.0</translateY>
+				</transform>
+				<crs>EPSG:26713</crs>
+			</grid>
+			<supportedFormats>
+				<string>GEOTIFF</string>
+				<string>PNG</string>
+			</supportedFormats>
+			<defaultInterpolationMethod>nearest neighbor</defaultInterpolationMethod>
+			<dimensions>
+				<coverageDimension>
+					<name>Band1</name>
+					<description>GridSampleDimension[-inf,inf]</description>
+					<range>
+						<min>-9999.0</min>
+						<max>2000.0</max>
+					</range>
+					<nullValues>
+						<double>-9999.0</double>
+					</nullValues>
+					<dimensionType>
+						<name>REAL_32BITS</name>
+					</dimensionType>
+				</coverageDimension>
+			</dimensions>
+			<parameters>
+				<entry>
+					<string>InputTransparentColor</string>
+					<string></string>
+				</entry>
+				<entry>
+					<string>SUGGESTED_TILE_SIZE</string>
+					<string>512,512</string>
+				</entry>
+			</parameters>
+		</coverage>
+		`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	coverage, err := cli.GetCoverage("sf", "sfdem", "sfdem")
+
+	assert.Nil(t, err)
+	assert.Equal(t, &Coverage{
+		XMLName: xml.Name{
+			Local: "coverage",
+		},
+		Name:       "sfdem",
+		NativeName: "sfdem",
+		Title:      "Spearfish elevation",
+		Keywords: &CoverageKeywords{
+			Keywords: []string{"WCS", "GeoTIFF"},
+		},
+		NativeCRS: &FeatureTypeCRS{
+			Class: "projected",
+			Value: "EPSG:26713",
+		},
+		SRS: "EPSG:26713",
+		NativeBoundingBox: &BoundingBox{
+			MinX: 589980,
+			MaxX: 609000,
+			MinY: 4913700,
+			MaxY: 4928010,
+			CRS: FeatureTypeCRS{
+				Class: "projected",
+				Value: "EPSG:26713",
+			},
+		},
+		ProjectionPolicy: "REPROJECT_TO_DECLARED",
+		Enabled:          true,
+		Store: &Resource{
+			Class: "coverageStore",
+			Name:  "sf:sfdem",
+		},
+		NativeFormat: "GeoTIFF",
+		Grid: &CoverageGrid{
+			Dimension: 2,
+			Low:       "0 0",
+			High:      "634 477",
+			Transform: &CoverageGridTransform{
+				ScaleX:     30,
+				ScaleY:     -30,
+				TranslateX: 589995,
+				TranslateY: 4927995,
+			},
+			CRS: "EPSG:26713",
+		},
+		SupportedFormats: &CoverageStrings{
+			List: []string{"GEOTIFF", "PNG"},
+		},
+		DefaultInterpolationMethod: "nearest neighbor",
+		Dimensions: &CoverageDimensions{
+			List: []*CoverageDimension{{
+				Name:        "Band1",
+				Description: "GridSampleDimension[-inf,inf]",
+				Range: &CoverageDimensionRange{
+					Min: -9999,
+					Max: 2000,
+				},
+				NullValues:    []float64{-9999},
+				DimensionType: "REAL_32BITS",
+			}},
+		},
+		Parameters: []*CoverageParameter{
+			{Key: "InputTransparentColor", Value: ""},
+			{Key: "SUGGESTED_TILE_SIZE", Value: "512,512"},
+		},
+	}, coverage)
+}
+
+func TestGetCoveragesSuccess(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/workspaces/sf/coverages", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<coverages>
+			<coverage>
+				<name>sfdem</name>
+			</coverage>
+		</coverages>
+		`))
+	})
+	mux.HandleFunc("/workspaces/sf/coverages/sfdem", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		w.Write([]byte(`<coverage><name>sfdem</name><enabled>true</enabled></coverage>`))
+	})
+
+	testServer := httptest.NewServer(mux)
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	coverages, err := cli.GetCoverages("sf", "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*Coverage{
+		{
+			XMLName: xml.Name{
+				Local: "coverage",
+			},
+			Name:    "sfdem",
+			Enabled: true,
+		},
+	}, coverages)
+}
+
+func TestGetAvailableCoveragesSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/workspaces/sf/coveragestores/mosaic/coverages")
+		assert.Equal(t, r.URL.Query().Get("list"), "available")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<list>
+			<coverageName>temperature</coverageName>
+			<coverageName>salinity</coverageName>
+		</list>
+		`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	names, err := cli.GetAvailableCoverages("sf", "mosaic")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"temperature", "salinity"}, names)
+
+	_, err = cli.GetAvailableCoverages("sf", "")
+	assert.NotNil(t, err)
+}
+
+func TestCreateCoverageSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/workspaces/sf/coveragestores/mosaic/coverages")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<coverage><name>temperature</name><nativeName>temperature</nativeName><enabled>true</enabled><metadata></metadata><parameters><entry><string>SORTING</string><string>time D</string></entry></parameters></coverage>`, string(rawBody))
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateCoverage("sf", "mosaic", &Coverage{
+		Name:       "temperature",
+		NativeName: "temperature",
+		Enabled:    true,
+		Parameters: []*CoverageParameter{
+			{Key: "SORTING", Value: "time D"},
+		},
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestUpdateCoverageSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/workspaces/sf/coveragestores/sfdem/coverages/sfdem")
+		assert.Equal(t, r.URL.Query().Get("calculate"), "nativebbox,latlonbbox")
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateCoverage("sf", "sfdem", "sfdem", &Coverage{Name: "sfdem", Title: "DEM"}, "nativebbox", "latlonbbox")
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteCoverageSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.Path, "/workspaces/sf/coveragestores/sfdem/coverages/sfdem")
+		assert.Equal(t, r.URL.Query().Get("recurse"), "true")
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteCoverage("sf", "sfdem", "sfdem", true)
+
+	assert.Nil(t, err)
+}
@@ -0,0 +1,240 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+)
+
+// CoverageStoreReference is a reference to a CoverageStore
+type CoverageStoreReference struct {
+	Name string `xml:"name"`
+}
+
+// CoverageStores is a list of CoverageStoreReference reference
+type CoverageStores struct {
+	XMLName xml.Name                  `xml:"coverageStores"`
+	List    []*CoverageStoreReference `xml:"coverageStore"`
+}
+
+// CoverageStore is a Geoserver object holding raster data, e.g. a GeoTIFF
+// file or an ImageMosaic directory
+type CoverageStore struct {
+	XMLName     xml.Name            `xml:"coverageStore"`
+	Name        string              `xml:"name"`
+	Description string              `xml:"description,omitempty"`
+	Type        string              `xml:"type"`
+	Enabled     bool                `xml:"enabled"`
+	Workspace   *WorkspaceReference `xml:"workspace"`
+	Default     bool                `xml:"__default"`
+	URL         string              `xml:"url"`
+}
+
+// GetCoverageStores returns the list of the coverage stores
+func (c *Client) GetCoverageStores(workspace string) (coverageStores []*CoverageStore, err error) {
+	return c.GetCoverageStoresContext(context.Background(), workspace)
+}
+
+// GetCoverageStoresContext is like GetCoverageStores but carries ctx down to the HTTP requests
+func (c *Client) GetCoverageStoresContext(ctx context.Context, workspace string) (coverageStores []*CoverageStore, err error) {
+	names, err := c.GetCoverageStoreNamesContext(ctx, workspace)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*CoverageStore, error) {
+		return c.GetCoverageStoreContext(ctx, workspace, name)
+	})
+}
+
+// GetCoverageStoreNames returns the names of the coverage stores, without fetching them
+func (c *Client) GetCoverageStoreNames(workspace string) (names []string, err error) {
+	return c.GetCoverageStoreNamesContext(context.Background(), workspace)
+}
+
+// GetCoverageStoreNamesContext is like GetCoverageStoreNames but carries ctx down to the HTTP requests
+func (c *Client) GetCoverageStoreNamesContext(ctx context.Context, workspace string) (names []string, err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/coveragestores", workspace)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data CoverageStores
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return names, nil
+	}
+
+	for _, coverageStoreRef := range data.List {
+		names = append(names, coverageStoreRef.Name)
+	}
+
+	return
+}
+
+// GetCoverageStore return a single coverage store based on its name
+func (c *Client) GetCoverageStore(workspace, name string) (coverageStore *CoverageStore, err error) {
+	return c.GetCoverageStoreContext(context.Background(), workspace, name)
+}
+
+// GetCoverageStoreContext is like GetCoverageStore but carries ctx down to the HTTP requests
+func (c *Client) GetCoverageStoreContext(ctx context.Context, workspace, name string) (coverageStore *CoverageStore, err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/coveragestores/%s", workspace, name)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data CoverageStore
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return coverageStore, err
+	}
+
+	coverageStore = &data
+
+	return
+}
+
+// CreateCoverageStore creates a coverage store
+func (c *Client) CreateCoverageStore(workspace string, coverageStore *CoverageStore) (err error) {
+	return c.CreateCoverageStoreContext(context.Background(), workspace, coverageStore)
+}
+
+// CreateCoverageStoreContext is like CreateCoverageStore but carries ctx down to the HTTP requests
+func (c *Client) CreateCoverageStoreContext(ctx context.Context, workspace string, coverageStore *CoverageStore) (err error) {
+	coverageStore.XMLName = xml.Name{
+		Local: "coverageStore",
+	}
+	payload, err := xml.Marshal(coverageStore)
+	if err != nil {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/workspaces/%s/coveragestores", workspace)
+	statusCode, body, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("POST", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 201:
+		return
+	default:
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// UpdateCoverageStore updates a coverage store
+func (c *Client) UpdateCoverageStore(workspaceName, coverageStoreName string, coverageStore *CoverageStore) (err error) {
+	return c.UpdateCoverageStoreContext(context.Background(), workspaceName, coverageStoreName, coverageStore)
+}
+
+// UpdateCoverageStoreContext is like UpdateCoverageStore but carries ctx down to the HTTP requests
+func (c *Client) UpdateCoverageStoreContext(ctx context.Context, workspaceName, coverageStoreName string, coverageStore *CoverageStore) (err error) {
+	coverageStore.XMLName = xml.Name{
+		Local: "coverageStore",
+	}
+	payload, err := xml.Marshal(coverageStore)
+	if err != nil {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/workspaces/%s/coveragestores/%s", workspaceName, coverageStoreName)
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// DeleteCoverageStore deletes a coverage store. purge tells what to remove
+// from the disk along with the configuration: "none", "metadata" or "all".
+// It is left to the GeoServer default when empty.
+func (c *Client) DeleteCoverageStore(workspaceName, coverageStoreName string, recurse bool, purge string) (err error) {
+	return c.DeleteCoverageStoreContext(context.Background(), workspaceName, coverageStoreName, recurse, purge)
+}
+
+// DeleteCoverageStoreContext is like DeleteCoverageStore but carries ctx down to the HTTP requests
+func (c *Client) DeleteCoverageStoreContext(ctx context.Context, workspaceName, coverageStoreName string, recurse bool, purge string) (err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/coveragestores/%s?recurse=%t", workspaceName, coverageStoreName, recurse)
+	if purge != "" {
+		endpoint = endpoint + "&purge=" + purge
+	}
+
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 403:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
+		return
+	case 404:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
+		return
+	}
+}
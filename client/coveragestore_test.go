@@ -0,0 +1,212 @@
+package client
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCoverageStoresSuccess(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/workspaces/nurc/coveragestores", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<coverageStores>
+			<coverageStore>
+				<name>arcGridSample</name>
+				<atom:link xmlns:atom="http://www.w3.org/2005/Atom" rel="alternate" href="http://localhost:8080/geoserver/rest/workspaces/nurc/coveragestores/arcGridSample.xml" type="application/xml"/>
+			</coverageStore>
+		</coverageStores>
+		`))
+	})
+	mux.HandleFunc("/workspaces/nurc/coveragestores/arcGridSample", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<coverageStore>
+			<name>arcGridSample</name>
+			<description>Sample ASCII GRID coverage of Global rainfall.</description>
+			<type>ArcGrid</type>
+			<enabled>true</enabled>
+			<workspace>
+				<name>nurc</name>
+			</workspace>
+			<__default>false</__default>
+			<url>file:coverages/arc_sample/precip30min.asc</url>
+			<coverages>
+				<atom:link xmlns:atom="http://www.w3.org/2005/Atom" rel="alternate" href="http://localhost:8080/geoserver/rest/workspaces/nurc/coveragestores/arcGridSample/coverages.xml" type="application/xml"/>
+			</coverages>
+		</coverageStore>
+		`))
+	})
+
+	testServer := httptest.NewServer(mux)
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	coverageStores, err := cli.GetCoverageStores("nurc")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*CoverageStore{
+		{
+			XMLName: xml.Name{
+				Local: "coverageStore",
+			},
+			Name:        "arcGridSample",
+			Description: "Sample ASCII GRID coverage of Global rainfall.",
+			Type:        "ArcGrid",
+			Enabled:     true,
+			Workspace: &WorkspaceReference{
+				Name: "nurc",
+			},
+			URL: "file:coverages/arc_sample/precip30min.asc",
+		},
+	}, coverageStores)
+}
+
+func TestGetCoverageStoreNotFound(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		w.Write([]byte(`No such coverage store: nurc,foo`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	coverageStore, err := cli.GetCoverageStore("nurc", "foo")
+
+	assert.Nil(t, coverageStore)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestCreateCoverageStoreSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/workspaces/nurc/coveragestores")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		var payload *CoverageStore
+		err = xml.Unmarshal(rawBody, &payload)
+		assert.Nil(t, err)
+		assert.Equal(t, &CoverageStore{
+			XMLName: xml.Name{
+				Local: "coverageStore",
+			},
+			Name:    "dem",
+			Type:    "GeoTIFF",
+			Enabled: true,
+			Workspace: &WorkspaceReference{
+				Name: "nurc",
+			},
+			URL: "file:data/dem.tif",
+		}, payload)
+
+		w.WriteHeader(201)
+		w.Write([]byte(`dem`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateCoverageStore("nurc", &CoverageStore{
+		Name:    "dem",
+		Type:    "GeoTIFF",
+		Enabled: true,
+		Workspace: &WorkspaceReference{
+			Name: "nurc",
+		},
+		URL: "file:data/dem.tif",
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestUpdateCoverageStoreSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/workspaces/nurc/coveragestores/dem")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		var payload *CoverageStore
+		err = xml.Unmarshal(rawBody, &payload)
+		assert.Nil(t, err)
+		assert.Equal(t, "file:data/dem_v2.tif", payload.URL)
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateCoverageStore("nurc", "dem", &CoverageStore{
+		Name:    "dem",
+		Type:    "GeoTIFF",
+		Enabled: true,
+		URL:     "file:data/dem_v2.tif",
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteCoverageStoreSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.Path, "/workspaces/nurc/coveragestores/dem")
+		assert.Equal(t, r.URL.Query().Get("recurse"), "true")
+		assert.Equal(t, r.URL.Query().Get("purge"), "all")
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteCoverageStore("nurc", "dem", true, "all")
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteCoverageStoreNotEmpty(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.False(t, r.URL.Query().Has("purge"))
+
+		w.WriteHeader(403)
+		w.Write([]byte(`coveragestore not empty`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteCoverageStore("nurc", "dem", false, "")
+
+	assert.True(t, errors.Is(err, ErrNotEmpty))
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -36,34 +37,52 @@ type Datastore struct {
 
 // GetDatastores returns the list of the datastores
 func (c *Client) GetDatastores(workspace string) (datastores []*Datastore, err error) {
-	statusCode, body, err := c.doRequest("GET", fmt.Sprintf("/workspaces/%s/datastores", workspace), nil)
+	return c.GetDatastoresContext(context.Background(), workspace)
+}
+
+// GetDatastoresContext is like GetDatastores but carries ctx down to the HTTP requests
+func (c *Client) GetDatastoresContext(ctx context.Context, workspace string) (datastores []*Datastore, err error) {
+	names, err := c.GetDatastoreNamesContext(ctx, workspace)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*Datastore, error) {
+		return c.GetDatastoreContext(ctx, workspace, name)
+	})
+}
+
+// GetDatastoreNames returns the names of the datastores, without fetching them
+func (c *Client) GetDatastoreNames(workspace string) (names []string, err error) {
+	return c.GetDatastoreNamesContext(context.Background(), workspace)
+}
+
+// GetDatastoreNamesContext is like GetDatastoreNames but carries ctx down to the HTTP requests
+func (c *Client) GetDatastoreNamesContext(ctx context.Context, workspace string) (names []string, err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/datastores", workspace)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
 	var data Datastores
 	if err := xml.Unmarshal([]byte(body), &data); err != nil {
-		return datastores, nil
+		return names, nil
 	}
 
 	for _, datastoreRef := range data.List {
-		datastore, err := c.GetDatastore(workspace, datastoreRef.Name)
-		if err != nil {
-			return datastores, err
-		}
-
-		datastores = append(datastores, datastore)
+		names = append(names, datastoreRef.Name)
 	}
 
 	return
@@ -71,22 +90,28 @@ func (c *Client) GetDatastores(workspace string) (datastores []*Datastore, err e
 
 // GetDatastore return a single datastore based on its name
 func (c *Client) GetDatastore(workspace, name string) (datastore *Datastore, err error) {
-	statusCode, body, err := c.doRequest("GET", fmt.Sprintf("/workspaces/%s/datastores/%s", workspace, name), nil)
+	return c.GetDatastoreContext(context.Background(), workspace, name)
+}
+
+// GetDatastoreContext is like GetDatastore but carries ctx down to the HTTP requests
+func (c *Client) GetDatastoreContext(ctx context.Context, workspace, name string) (datastore *Datastore, err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/datastores/%s", workspace, name)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -102,75 +127,93 @@ func (c *Client) GetDatastore(workspace, name string) (datastore *Datastore, err
 
 // CreateDatastore creates a datastore
 func (c *Client) CreateDatastore(workspace string, datastore *Datastore) (err error) {
+	return c.CreateDatastoreContext(context.Background(), workspace, datastore)
+}
+
+// CreateDatastoreContext is like CreateDatastore but carries ctx down to the HTTP requests
+func (c *Client) CreateDatastoreContext(ctx context.Context, workspace string, datastore *Datastore) (err error) {
 	payload, _ := xml.Marshal(&datastore)
-	statusCode, body, err := c.doRequest("POST", fmt.Sprintf("/workspaces/%s/datastores", workspace), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/workspaces/%s/datastores", workspace)
+	statusCode, body, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateDatastore updates a datastore
 func (c *Client) UpdateDatastore(workspaceName, datastoreName string, datastore *Datastore) (err error) {
+	return c.UpdateDatastoreContext(context.Background(), workspaceName, datastoreName, datastore)
+}
+
+// UpdateDatastoreContext is like UpdateDatastore but carries ctx down to the HTTP requests
+func (c *Client) UpdateDatastoreContext(ctx context.Context, workspaceName, datastoreName string, datastore *Datastore) (err error) {
 	payload, _ := xml.Marshal(&datastore)
 
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/workspaces/%s/datastores/%s", workspaceName, datastoreName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/workspaces/%s/datastores/%s", workspaceName, datastoreName)
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteDatastore deletes a datastore
 func (c *Client) DeleteDatastore(workspaceName, datastoreName string, recurse bool) (err error) {
-	statusCode, body, err := c.doRequest("DELETE", fmt.Sprintf("/workspaces/%s/datastores/%s?recurse=%t", workspaceName, datastoreName, recurse), nil)
+	return c.DeleteDatastoreContext(context.Background(), workspaceName, datastoreName, recurse)
+}
+
+// DeleteDatastoreContext is like DeleteDatastore but carries ctx down to the HTTP requests
+func (c *Client) DeleteDatastoreContext(ctx context.Context, workspaceName, datastoreName string, recurse bool) (err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/datastores/%s?recurse=%t", workspaceName, datastoreName, recurse)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
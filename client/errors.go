@@ -0,0 +1,71 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors matching the meaningful GeoServer answers. Every error
+// built from a response wraps one of them when possible, so that callers can
+// use errors.Is instead of comparing messages.
+var (
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrNotEmpty     = errors.New("not empty")
+	// ErrPolicyViolation is returned when a password change is rejected,
+	// e.g. by the password policy or because the old password is wrong
+	ErrPolicyViolation = errors.New("policy violation")
+)
+
+// APIError is returned when GeoServer answers with an unexpected status code
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Body       string
+
+	// Err is the sentinel error matching the status code, if any
+	Err error
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	reason := "unknown error"
+	if e.Err != nil {
+		reason = e.Err.Error()
+	}
+
+	return fmt.Sprintf("%s %s: %s: %d - %s", e.Method, e.Path, reason, e.StatusCode, e.Body)
+}
+
+// Unwrap returns the sentinel error matching the status code
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// newAPIError builds the error returned for a response. When sentinel is nil,
+// it is guessed from the status code.
+func newAPIError(method, path string, statusCode int, body string, sentinel error) error {
+	if sentinel == nil {
+		switch statusCode {
+		case 401:
+			sentinel = ErrUnauthorized
+		case 403:
+			sentinel = ErrForbidden
+		case 404:
+			sentinel = ErrNotFound
+		case 409:
+			sentinel = ErrConflict
+		}
+	}
+
+	return &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		Path:       path,
+		Body:       body,
+		Err:        sentinel,
+	}
+}
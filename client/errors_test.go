@@ -0,0 +1,112 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIErrorNotFound(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		w.Write([]byte(`No such workspace: foo`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	_, err := cli.GetWorkspace("foo")
+
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrUnauthorized))
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 404, apiErr.StatusCode)
+	assert.Equal(t, "GET", apiErr.Method)
+	assert.Equal(t, "/workspaces/foo", apiErr.Path)
+	assert.Equal(t, "No such workspace: foo", apiErr.Body)
+}
+
+func TestAPIErrorNotEmpty(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(403)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteWorkspace("foo", false)
+
+	assert.True(t, errors.Is(err, ErrNotEmpty))
+}
+
+func TestAPIErrorUnknownStatus(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(500)
+		w.Write([]byte(`boom`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateLayer("", "foo", &Layer{})
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 500, apiErr.StatusCode)
+	assert.Equal(t, "PUT", apiErr.Method)
+	assert.Nil(t, apiErr.Err)
+	assert.Equal(t, "PUT /layers/foo: unknown error: 500 - boom", err.Error())
+}
+
+func TestAPIErrorGuessedSentinel(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(401)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	_, err := cli.GetGwcQuotaConfiguration()
+
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+}
+
+func TestClientSideNotFound(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		w.Write([]byte(`<users></users>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	_, err := cli.GetUser("", "alice")
+
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestAPIErrorUnprocessableEntity(t *testing.T) {
+	err := newAPIError("POST", "/workspaces/topp/datastores/roads/file.shp", 422, "", nil)
+
+	assert.False(t, errors.Is(err, ErrPolicyViolation))
+}
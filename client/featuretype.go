@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -67,6 +68,28 @@ type FeatureTypeAttribute struct {
 
 // GetFeatureTypes returns all the layers
 func (c *Client) GetFeatureTypes(workspace, datastore string) (featureTypes []*FeatureType, err error) {
+	return c.GetFeatureTypesContext(context.Background(), workspace, datastore)
+}
+
+// GetFeatureTypesContext is like GetFeatureTypes but carries ctx down to the HTTP requests
+func (c *Client) GetFeatureTypesContext(ctx context.Context, workspace, datastore string) (featureTypes []*FeatureType, err error) {
+	names, err := c.GetFeatureTypeNamesContext(ctx, workspace, datastore)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*FeatureType, error) {
+		return c.GetFeatureTypeContext(ctx, workspace, datastore, name)
+	})
+}
+
+// GetFeatureTypeNames returns the names of the feature types, without fetching them
+func (c *Client) GetFeatureTypeNames(workspace, datastore string) (names []string, err error) {
+	return c.GetFeatureTypeNamesContext(context.Background(), workspace, datastore)
+}
+
+// GetFeatureTypeNamesContext is like GetFeatureTypeNames but carries ctx down to the HTTP requests
+func (c *Client) GetFeatureTypeNamesContext(ctx context.Context, workspace, datastore string) (names []string, err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -79,35 +102,30 @@ func (c *Client) GetFeatureTypes(workspace, datastore string) (featureTypes []*F
 		endpoint = fmt.Sprintf("/workspaces/%s/datastores/%s/featuretypes", workspace, datastore)
 	}
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
 	var data FeatureTypes
 
 	if err := xml.Unmarshal([]byte(body), &data); err != nil {
-		return featureTypes, err
+		return names, err
 	}
 
 	for _, featureTypeRef := range data.List {
-		featureType, err := c.GetFeatureType(workspace, datastore, featureTypeRef.Name)
-		if err != nil {
-			return featureTypes, err
-		}
-
-		featureTypes = append(featureTypes, featureType)
+		names = append(names, featureTypeRef.Name)
 	}
 
 	return
@@ -115,6 +133,11 @@ func (c *Client) GetFeatureTypes(workspace, datastore string) (featureTypes []*F
 
 // GetFeatureType return a single featuretype based on its name
 func (c *Client) GetFeatureType(workspace, datastore, name string) (featureType *FeatureType, err error) {
+	return c.GetFeatureTypeContext(context.Background(), workspace, datastore, name)
+}
+
+// GetFeatureTypeContext is like GetFeatureType but carries ctx down to the HTTP requests
+func (c *Client) GetFeatureTypeContext(ctx context.Context, workspace, datastore, name string) (featureType *FeatureType, err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -127,22 +150,22 @@ func (c *Client) GetFeatureType(workspace, datastore, name string) (featureType
 		endpoint = fmt.Sprintf("/workspaces/%s/datastores/%s/featuretypes/%s", workspace, datastore, name)
 	}
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -158,6 +181,11 @@ func (c *Client) GetFeatureType(workspace, datastore, name string) (featureType
 
 // CreateFeatureType creates a Feature Type
 func (c *Client) CreateFeatureType(workspace string, datastore string, featureType *FeatureType) (err error) {
+	return c.CreateFeatureTypeContext(context.Background(), workspace, datastore, featureType)
+}
+
+// CreateFeatureTypeContext is like CreateFeatureType but carries ctx down to the HTTP requests
+func (c *Client) CreateFeatureTypeContext(ctx context.Context, workspace string, datastore string, featureType *FeatureType) (err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -177,25 +205,30 @@ func (c *Client) CreateFeatureType(workspace string, datastore string, featureTy
 	if err != nil {
 		return
 	}
-	statusCode, body, err := c.doRequest("POST", endpoint, bytes.NewBuffer(payload))
+	statusCode, body, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s - %s", statusCode, body, string(payload))
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateFeatureType updates a featuretype
 func (c *Client) UpdateFeatureType(workspace, datastore, featureTypeName string, featureType *FeatureType, recalculateAttributes bool) (err error) {
+	return c.UpdateFeatureTypeContext(context.Background(), workspace, datastore, featureTypeName, featureType, recalculateAttributes)
+}
+
+// UpdateFeatureTypeContext is like UpdateFeatureType but carries ctx down to the HTTP requests
+func (c *Client) UpdateFeatureTypeContext(ctx context.Context, workspace, datastore, featureTypeName string, featureType *FeatureType, recalculateAttributes bool) (err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -217,31 +250,36 @@ func (c *Client) UpdateFeatureType(workspace, datastore, featureTypeName string,
 	}
 	payload, _ := xml.Marshal(featureType)
 
-	statusCode, body, err := c.doRequest("PUT", endpoint, bytes.NewBuffer(payload))
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteFeatureType deletes a datastore
 func (c *Client) DeleteFeatureType(workspace, datastore, featureType string, recurse bool) (err error) {
+	return c.DeleteFeatureTypeContext(context.Background(), workspace, datastore, featureType, recurse)
+}
+
+// DeleteFeatureTypeContext is like DeleteFeatureType but carries ctx down to the HTTP requests
+func (c *Client) DeleteFeatureTypeContext(ctx context.Context, workspace, datastore, featureType string, recurse bool) (err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -253,28 +291,28 @@ func (c *Client) DeleteFeatureType(workspace, datastore, featureType string, rec
 	} else {
 		endpoint = fmt.Sprintf("/workspaces/%s/datastores/%s/featuretypes/%s?recurse=%t", workspace, datastore, featureType, recurse)
 	}
-	statusCode, body, err := c.doRequest("DELETE", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
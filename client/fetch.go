@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// fetchAll calls fetch for every name and returns the results in the same
+// order. Up to Client.MaxConcurrency calls run at the same time; the first
+// error cancels the remaining ones. Like fetching the items one after the
+// other, an error comes with the results fetched before the first item
+// missing.
+func fetchAll[T any](ctx context.Context, c *Client, names []string, fetch func(ctx context.Context, name string) (T, error)) (items []T, err error) {
+	if len(names) == 0 {
+		return
+	}
+
+	if c.MaxConcurrency <= 1 {
+		for _, name := range names {
+			item, err := fetch(ctx, name)
+			if err != nil {
+				return items, err
+			}
+
+			items = append(items, item)
+		}
+
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg        sync.WaitGroup
+		errOnce   sync.Once
+		semaphore = make(chan struct{}, c.MaxConcurrency)
+		fetched   = make([]bool, len(names))
+	)
+	items = make([]T, len(names))
+
+	for i, name := range names {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			item, fetchErr := fetch(ctx, name)
+			if fetchErr != nil {
+				errOnce.Do(func() { err = fetchErr })
+				cancel()
+				return
+			}
+			items[i] = item
+			fetched[i] = true
+		}()
+	}
+	wg.Wait()
+
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		if missing := slices.Index(fetched, false); missing != -1 {
+			items = items[:missing]
+		}
+		return items, err
+	}
+
+	return
+}
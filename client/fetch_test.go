@@ -0,0 +1,130 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newDatastoresServer(t *testing.T, count int, handleItem func(name string, w http.ResponseWriter)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/workspaces/foo/datastores" {
+			var list strings.Builder
+			for i := 0; i < count; i++ {
+				fmt.Fprintf(&list, "<dataStore><name>ds%d</name></dataStore>", i)
+			}
+			w.WriteHeader(200)
+			w.Write([]byte("<dataStores>" + list.String() + "</dataStores>"))
+			return
+		}
+
+		handleItem(strings.TrimPrefix(r.URL.Path, "/workspaces/foo/datastores/"), w)
+	}))
+}
+
+func TestGetDatastoresConcurrently(t *testing.T) {
+	var running, maxRunning int32
+	var mu sync.Mutex
+	testServer := newDatastoresServer(t, 20, func(name string, w http.ResponseWriter) {
+		current := atomic.AddInt32(&running, 1)
+		mu.Lock()
+		maxRunning = max(maxRunning, current)
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+
+		w.WriteHeader(200)
+		w.Write([]byte("<dataStore><name>" + name + "</name></dataStore>"))
+	})
+	defer testServer.Close()
+
+	cli, err := NewClient(testServer.URL, "", "", WithMaxConcurrency(4))
+	assert.Nil(t, err)
+
+	datastores, err := cli.GetDatastores("foo")
+
+	assert.Nil(t, err)
+	assert.Len(t, datastores, 20)
+	for i, datastore := range datastores {
+		assert.Equal(t, fmt.Sprintf("ds%d", i), datastore.Name)
+	}
+	assert.LessOrEqual(t, maxRunning, int32(4))
+	assert.Greater(t, maxRunning, int32(1))
+}
+
+func TestGetDatastoresConcurrentlyError(t *testing.T) {
+	var fetched int32
+	testServer := newDatastoresServer(t, 50, func(name string, w http.ResponseWriter) {
+		atomic.AddInt32(&fetched, 1)
+		if name == "ds3" {
+			w.WriteHeader(500)
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		w.WriteHeader(200)
+		w.Write([]byte("<dataStore><name>" + name + "</name></dataStore>"))
+	})
+	defer testServer.Close()
+
+	cli, err := NewClient(testServer.URL, "", "", WithMaxConcurrency(2))
+	assert.Nil(t, err)
+
+	datastores, err := cli.GetDatastores("foo")
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "/workspaces/foo/datastores/ds3", apiErr.Path)
+	assert.LessOrEqual(t, len(datastores), 3)
+	for i, datastore := range datastores {
+		assert.Equal(t, fmt.Sprintf("ds%d", i), datastore.Name)
+	}
+	assert.Less(t, atomic.LoadInt32(&fetched), int32(50))
+}
+
+func TestGetDatastoresPartialResults(t *testing.T) {
+	testServer := newDatastoresServer(t, 5, func(name string, w http.ResponseWriter) {
+		if name == "ds3" {
+			w.WriteHeader(500)
+			return
+		}
+
+		w.WriteHeader(200)
+		w.Write([]byte("<dataStore><name>" + name + "</name></dataStore>"))
+	})
+	defer testServer.Close()
+
+	cli, err := NewClient(testServer.URL, "", "")
+	assert.Nil(t, err)
+
+	datastores, err := cli.GetDatastores("foo")
+
+	assert.NotNil(t, err)
+	assert.Len(t, datastores, 3)
+	assert.Equal(t, "ds2", datastores[2].Name)
+}
+
+func TestGetDatastoreNames(t *testing.T) {
+	testServer := newDatastoresServer(t, 3, func(name string, w http.ResponseWriter) {
+		t.Errorf("datastore %s should not be fetched", name)
+	})
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	names, err := cli.GetDatastoreNames("foo")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ds0", "ds1", "ds2"}, names)
+}
@@ -0,0 +1,1358 @@
+// Package geoservertest provides an in-memory GeoServer for tests.
+//
+// The Server implements the REST endpoints used by the client package for
+// workspaces, namespaces, datastores, feature types, layers, layer groups, styles, layer
+// ACL rules, users, groups, GeoWebCache layers, gridsets and blobstores. It keeps
+// its state between requests, so that a resource created through the client
+// can be read back, updated and deleted like on a real instance.
+package geoservertest
+
+import (
+	"encoding/xml"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/camptocamp/go-geoserver/client"
+)
+
+// Server is an in-memory GeoServer. Its URL is the root of the instance, the
+// REST API being served under /rest and the GeoWebCache REST API under
+// /gwc/rest.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	workspaces   map[string]*client.Workspace
+	namespaces   map[string]string
+	datastores   map[key]*client.Datastore
+	featureTypes map[key]*featureType
+	layers       map[key]*client.Layer
+	layerGroups  map[key]*client.LayerGroup
+	styles       map[key]*style
+	layerRules   []*client.LayerRule
+	users        map[key]*client.User
+	groups       map[key][]string
+	gwcLayers    map[string][]byte
+	gridsets     map[string]*client.Gridset
+	blobstores   map[string][]byte
+	diskQuota    *client.GwcQuotaConfiguration
+}
+
+// key identifies a resource by its name within a scope, e.g. a workspace.
+// Global resources have an empty scope.
+type key struct {
+	scope string
+	name  string
+}
+
+type featureType struct {
+	datastore string
+	value     *client.FeatureType
+}
+
+type style struct {
+	value   *client.Style
+	content string
+}
+
+// defaultUserGroupService is the service used when none is given
+const defaultUserGroupService = "default"
+
+// NewServer starts an empty in-memory GeoServer. It must be closed when done.
+func NewServer() *Server {
+	s := &Server{
+		workspaces:   map[string]*client.Workspace{},
+		namespaces:   map[string]string{},
+		datastores:   map[key]*client.Datastore{},
+		featureTypes: map[key]*featureType{},
+		layers:       map[key]*client.Layer{},
+		layerGroups:  map[key]*client.LayerGroup{},
+		styles:       map[key]*style{},
+		users:        map[key]*client.User{},
+		groups:       map[key][]string{},
+		gwcLayers:    map[string][]byte{},
+		gridsets:     map[string]*client.Gridset{},
+		blobstores:   map[string][]byte{},
+		diskQuota: &client.GwcQuotaConfiguration{
+			CacheCleanUpFrequency:      10,
+			CacheCleanUpUnits:          "SECONDS",
+			MaxConcurrentCleanUps:      2,
+			GlobalExpirationPolicyName: "LFU",
+			GlobalQuota:                client.GwcQuota{Value: 500, Units: "MiB"},
+		},
+	}
+
+	s.Server = httptest.NewServer(s.handler())
+
+	return s
+}
+
+// Client returns a client connected to the server
+func (s *Server) Client(options ...client.Option) *client.Client {
+	cli, err := client.NewClientFromRoot(s.URL, "admin", "geoserver", options...)
+	if err != nil {
+		panic(err)
+	}
+
+	return cli
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /rest/workspaces", s.getWorkspaces)
+	mux.HandleFunc("POST /rest/workspaces", s.createWorkspace)
+	mux.HandleFunc("GET /rest/workspaces/{ws}", s.getWorkspace)
+	mux.HandleFunc("PUT /rest/workspaces/{ws}", s.updateWorkspace)
+	mux.HandleFunc("DELETE /rest/workspaces/{ws}", s.deleteWorkspace)
+
+	mux.HandleFunc("GET /rest/namespaces", s.getNamespaces)
+	mux.HandleFunc("POST /rest/namespaces", s.createNamespace)
+	mux.HandleFunc("GET /rest/namespaces/{ws}", s.getNamespace)
+	mux.HandleFunc("PUT /rest/namespaces/{ws}", s.updateNamespace)
+	mux.HandleFunc("DELETE /rest/namespaces/{ws}", s.deleteWorkspace)
+
+	mux.HandleFunc("GET /rest/workspaces/{ws}/datastores", s.getDatastores)
+	mux.HandleFunc("POST /rest/workspaces/{ws}/datastores", s.createDatastore)
+	mux.HandleFunc("GET /rest/workspaces/{ws}/datastores/{ds}", s.getDatastore)
+	mux.HandleFunc("PUT /rest/workspaces/{ws}/datastores/{ds}", s.updateDatastore)
+	mux.HandleFunc("DELETE /rest/workspaces/{ws}/datastores/{ds}", s.deleteDatastore)
+
+	for _, prefix := range []string{"/rest/workspaces/{ws}", "/rest/workspaces/{ws}/datastores/{ds}"} {
+		mux.HandleFunc("GET "+prefix+"/featuretypes", s.getFeatureTypes)
+		mux.HandleFunc("POST "+prefix+"/featuretypes", s.createFeatureType)
+		mux.HandleFunc("GET "+prefix+"/featuretypes/{ft}", s.getFeatureType)
+		mux.HandleFunc("PUT "+prefix+"/featuretypes/{ft}", s.updateFeatureType)
+		mux.HandleFunc("DELETE "+prefix+"/featuretypes/{ft}", s.deleteFeatureType)
+	}
+
+	for _, prefix := range []string{"/rest", "/rest/workspaces/{ws}"} {
+		mux.HandleFunc("GET "+prefix+"/layers", s.getLayers)
+		mux.HandleFunc("GET "+prefix+"/layers/{layer}", s.getLayer)
+		mux.HandleFunc("PUT "+prefix+"/layers/{layer}", s.updateLayer)
+		mux.HandleFunc("DELETE "+prefix+"/layers/{layer}", s.deleteLayer)
+
+		mux.HandleFunc("GET "+prefix+"/layergroups", s.getLayerGroups)
+		mux.HandleFunc("POST "+prefix+"/layergroups", s.createLayerGroup)
+		mux.HandleFunc("GET "+prefix+"/layergroups/{group}", s.getLayerGroup)
+		mux.HandleFunc("PUT "+prefix+"/layergroups/{group}", s.updateLayerGroup)
+		mux.HandleFunc("DELETE "+prefix+"/layergroups/{group}", s.deleteLayerGroup)
+
+		mux.HandleFunc("GET "+prefix+"/styles", s.getStyles)
+		mux.HandleFunc("POST "+prefix+"/styles", s.createStyle)
+		mux.HandleFunc("GET "+prefix+"/styles/{style}", s.getStyle)
+		mux.HandleFunc("PUT "+prefix+"/styles/{style}", s.updateStyle)
+		mux.HandleFunc("DELETE "+prefix+"/styles/{style}", s.deleteStyle)
+	}
+
+	mux.HandleFunc("GET /rest/security/acl/layers", s.getLayerRules)
+	mux.HandleFunc("POST /rest/security/acl/layers", s.createLayerRules)
+	mux.HandleFunc("PUT /rest/security/acl/layers", s.updateLayerRules)
+	mux.HandleFunc("DELETE /rest/security/acl/layers/{rule}", s.deleteLayerRule)
+
+	for _, prefix := range []string{"/rest/security/usergroup", "/rest/security/usergroup/service/{service}"} {
+		mux.HandleFunc("GET "+prefix+"/users", s.getUsers)
+		mux.HandleFunc("POST "+prefix+"/users", s.createUser)
+		mux.HandleFunc("POST "+prefix+"/user/{user}", s.updateUser)
+		mux.HandleFunc("DELETE "+prefix+"/user/{user}", s.deleteUser)
+
+		mux.HandleFunc("GET "+prefix+"/groups", s.getGroups)
+		mux.HandleFunc("POST "+prefix+"/group/{group}", s.createGroup)
+		mux.HandleFunc("DELETE "+prefix+"/group/{group}", s.deleteGroup)
+		mux.HandleFunc("GET "+prefix+"/group/{group}/users", s.getGroupMembers)
+		mux.HandleFunc("GET "+prefix+"/user/{user}/groups", s.getUserMemberships)
+		mux.HandleFunc("POST "+prefix+"/user/{user}/group/{group}", s.addGroupMember)
+		mux.HandleFunc("DELETE "+prefix+"/user/{user}/group/{group}", s.removeGroupMember)
+	}
+
+	mux.HandleFunc("GET /gwc/rest/layers", s.getGwcLayers)
+	mux.HandleFunc("GET /gwc/rest/layers/{layer}", s.getGwcLayer)
+	mux.HandleFunc("PUT /gwc/rest/layers/{layer}", s.putGwcLayer)
+	mux.HandleFunc("DELETE /gwc/rest/layers/{layer}", s.deleteGwcLayer)
+
+	mux.HandleFunc("GET /gwc/rest/gridsets", s.getGridsets)
+	mux.HandleFunc("GET /gwc/rest/gridsets/{gridset}", s.getGridset)
+	mux.HandleFunc("PUT /gwc/rest/gridsets/{gridset}", s.putGridset)
+	mux.HandleFunc("DELETE /gwc/rest/gridsets/{gridset}", s.deleteGridset)
+
+	mux.HandleFunc("GET /gwc/rest/blobstores", s.getBlobstores)
+	mux.HandleFunc("GET /gwc/rest/blobstores/{blobstore}", s.getBlobstore)
+	mux.HandleFunc("PUT /gwc/rest/blobstores/{blobstore}", s.putBlobstore)
+	mux.HandleFunc("DELETE /gwc/rest/blobstores/{blobstore}", s.deleteBlobstore)
+
+	mux.HandleFunc("GET /gwc/rest/diskquota.xml", s.getDiskQuota)
+	mux.HandleFunc("PUT /gwc/rest/diskquota.xml", s.updateDiskQuota)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func writeXML(w http.ResponseWriter, statusCode int, v any) {
+	payload, err := xml.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+	w.Write(payload)
+}
+
+func readXML(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := xml.NewDecoder(r.Body).Decode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+
+	return true
+}
+
+func notFound(w http.ResponseWriter, kind, name string) {
+	http.Error(w, "No such "+kind+": "+name, http.StatusNotFound)
+}
+
+func conflict(w http.ResponseWriter, kind, name string) {
+	http.Error(w, kind+" '"+name+"' already exists", http.StatusConflict)
+}
+
+// sortedNames returns the names of the resources within scope, sorted
+func sortedNames[V any](resources map[key]V, scope string) (names []string) {
+	for k := range resources {
+		if k.scope == scope {
+			names = append(names, k.name)
+		}
+	}
+	slices.Sort(names)
+
+	return
+}
+
+// hasScope tells whether a resource exists within scope
+func hasScope[V any](resources map[key]V, scope string) bool {
+	for k := range resources {
+		if k.scope == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// deleteScope removes all the resources within scope
+func deleteScope[V any](resources map[key]V, scope string) {
+	for k := range resources {
+		if k.scope == scope {
+			delete(resources, k)
+		}
+	}
+}
+
+func recurse(r *http.Request) bool {
+	return r.URL.Query().Get("recurse") == "true"
+}
+
+// workspace returns the workspace of the request, writing an error when it
+// does not exist
+func (s *Server) workspace(w http.ResponseWriter, r *http.Request) (string, bool) {
+	ws := r.PathValue("ws")
+	if ws == "" {
+		return "", true
+	}
+	if _, ok := s.workspaces[ws]; !ok {
+		notFound(w, "workspace", ws)
+		return ws, false
+	}
+
+	return ws, true
+}
+
+func (s *Server) getWorkspaces(w http.ResponseWriter, r *http.Request) {
+	data := client.Workspaces{}
+	for _, name := range slices.Sorted(maps.Keys(s.workspaces)) {
+		data.List = append(data.List, &client.WorkspaceReference{Name: name})
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) createWorkspace(w http.ResponseWriter, r *http.Request) {
+	var workspace client.Workspace
+	if !readXML(w, r, &workspace) {
+		return
+	}
+	if _, ok := s.workspaces[workspace.Name]; ok {
+		conflict(w, "Workspace", workspace.Name)
+		return
+	}
+
+	s.workspaces[workspace.Name] = &workspace
+	s.namespaces[workspace.Name] = "http://" + workspace.Name
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) getWorkspace(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	writeXML(w, http.StatusOK, s.workspaces[ws])
+}
+
+func (s *Server) updateWorkspace(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	var workspace client.Workspace
+	if !readXML(w, r, &workspace) {
+		return
+	}
+	if workspace.Name == "" {
+		workspace.Name = ws
+	}
+	if workspace.Name != ws {
+		http.Error(w, "Can't change the name of a workspace", http.StatusForbidden)
+		return
+	}
+
+	s.workspaces[ws] = &workspace
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteWorkspace(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	empty := !hasScope(s.datastores, ws) && !hasScope(s.layerGroups, ws) && !hasScope(s.styles, ws)
+	if !empty && !recurse(r) {
+		http.Error(w, "Workspace not empty", http.StatusForbidden)
+		return
+	}
+
+	deleteScope(s.datastores, ws)
+	deleteScope(s.featureTypes, ws)
+	deleteScope(s.layers, ws)
+	deleteScope(s.layerGroups, ws)
+	deleteScope(s.styles, ws)
+	delete(s.workspaces, ws)
+	delete(s.namespaces, ws)
+	w.WriteHeader(http.StatusOK)
+}
+
+// Namespaces live along with the workspaces of the same name, only their URI
+// being stored apart
+
+func (s *Server) getNamespaces(w http.ResponseWriter, r *http.Request) {
+	data := client.Namespaces{}
+	for _, name := range slices.Sorted(maps.Keys(s.workspaces)) {
+		data.List = append(data.List, &client.NamespaceReference{Name: name})
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) createNamespace(w http.ResponseWriter, r *http.Request) {
+	var namespace client.Namespace
+	if !readXML(w, r, &namespace) {
+		return
+	}
+	if _, ok := s.workspaces[namespace.Prefix]; ok {
+		conflict(w, "Namespace", namespace.Prefix)
+		return
+	}
+
+	s.workspaces[namespace.Prefix] = &client.Workspace{Name: namespace.Prefix, Isolated: namespace.Isolated}
+	s.namespaces[namespace.Prefix] = namespace.URI
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) getNamespace(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	writeXML(w, http.StatusOK, &client.Namespace{
+		Prefix:   ws,
+		URI:      s.namespaces[ws],
+		Isolated: s.workspaces[ws].Isolated,
+	})
+}
+
+func (s *Server) updateNamespace(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	var namespace client.Namespace
+	if !readXML(w, r, &namespace) {
+		return
+	}
+	if namespace.Prefix != "" && namespace.Prefix != ws {
+		http.Error(w, "Can't change the prefix of a namespace", http.StatusForbidden)
+		return
+	}
+
+	s.workspaces[ws].Isolated = namespace.Isolated
+	s.namespaces[ws] = namespace.URI
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getDatastores(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	data := client.Datastores{}
+	for _, name := range sortedNames(s.datastores, ws) {
+		data.List = append(data.List, &client.DatastoreReference{Name: name})
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) createDatastore(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	var datastore client.Datastore
+	if !readXML(w, r, &datastore) {
+		return
+	}
+	k := key{ws, datastore.Name}
+	if _, ok := s.datastores[k]; ok {
+		conflict(w, "Store", datastore.Name)
+		return
+	}
+
+	datastore.Workspace = &client.WorkspaceReference{Name: ws}
+	s.datastores[k] = &datastore
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) getDatastore(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	datastore, ok := s.datastores[key{ws, r.PathValue("ds")}]
+	if !ok {
+		notFound(w, "datastore", r.PathValue("ds"))
+		return
+	}
+
+	writeXML(w, http.StatusOK, datastore)
+}
+
+func (s *Server) updateDatastore(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	k := key{ws, r.PathValue("ds")}
+	if _, ok := s.datastores[k]; !ok {
+		notFound(w, "datastore", k.name)
+		return
+	}
+
+	var datastore client.Datastore
+	if !readXML(w, r, &datastore) {
+		return
+	}
+	datastore.Name = k.name
+	datastore.Workspace = &client.WorkspaceReference{Name: ws}
+	s.datastores[k] = &datastore
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteDatastore(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	k := key{ws, r.PathValue("ds")}
+	if _, ok := s.datastores[k]; !ok {
+		notFound(w, "datastore", k.name)
+		return
+	}
+
+	var contained []key
+	for ftKey, ft := range s.featureTypes {
+		if ftKey.scope == ws && ft.datastore == k.name {
+			contained = append(contained, ftKey)
+		}
+	}
+	if len(contained) > 0 && !recurse(r) {
+		http.Error(w, "Store not empty", http.StatusForbidden)
+		return
+	}
+
+	for _, ftKey := range contained {
+		delete(s.featureTypes, ftKey)
+		delete(s.layers, ftKey)
+	}
+	delete(s.datastores, k)
+	w.WriteHeader(http.StatusOK)
+}
+
+// featureType returns the feature type of the request, writing an error when
+// it does not exist
+func (s *Server) featureType(w http.ResponseWriter, r *http.Request) (key, *featureType, bool) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return key{}, nil, false
+	}
+
+	k := key{ws, r.PathValue("ft")}
+	ft, ok := s.featureTypes[k]
+	if !ok || (r.PathValue("ds") != "" && ft.datastore != r.PathValue("ds")) {
+		notFound(w, "feature type", k.name)
+		return k, nil, false
+	}
+
+	return k, ft, true
+}
+
+func (s *Server) getFeatureTypes(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	data := client.FeatureTypes{}
+	for _, name := range sortedNames(s.featureTypes, ws) {
+		if ds := r.PathValue("ds"); ds != "" && s.featureTypes[key{ws, name}].datastore != ds {
+			continue
+		}
+		data.List = append(data.List, &client.FeatureType{Name: name})
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) createFeatureType(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	ds := r.PathValue("ds")
+	if ds == "" {
+		// Like GeoServer, use the first store of the workspace
+		names := sortedNames(s.datastores, ws)
+		if len(names) == 0 {
+			http.Error(w, "No store in workspace "+ws, http.StatusBadRequest)
+			return
+		}
+		ds = names[0]
+	} else if _, ok := s.datastores[key{ws, ds}]; !ok {
+		notFound(w, "datastore", ds)
+		return
+	}
+
+	var value client.FeatureType
+	if !readXML(w, r, &value) {
+		return
+	}
+	if value.NativeName == "" {
+		value.NativeName = value.Name
+	}
+	k := key{ws, value.Name}
+	if _, ok := s.featureTypes[k]; ok {
+		conflict(w, "Resource", value.Name)
+		return
+	}
+
+	s.featureTypes[k] = &featureType{datastore: ds, value: &value}
+	s.layers[k] = &client.Layer{
+		Name:         value.Name,
+		Type:         "VECTOR",
+		DefaultStyle: "generic",
+		LayerResource: client.Resource{
+			Class: "featureType",
+			Name:  ws + ":" + value.Name,
+		},
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) getFeatureType(w http.ResponseWriter, r *http.Request) {
+	_, ft, ok := s.featureType(w, r)
+	if !ok {
+		return
+	}
+
+	writeXML(w, http.StatusOK, ft.value)
+}
+
+func (s *Server) updateFeatureType(w http.ResponseWriter, r *http.Request) {
+	k, ft, ok := s.featureType(w, r)
+	if !ok {
+		return
+	}
+
+	var value client.FeatureType
+	if !readXML(w, r, &value) {
+		return
+	}
+	value.Name = k.name
+	ft.value = &value
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteFeatureType(w http.ResponseWriter, r *http.Request) {
+	k, _, ok := s.featureType(w, r)
+	if !ok {
+		return
+	}
+
+	if _, ok := s.layers[k]; ok && !recurse(r) {
+		http.Error(w, "Feature type is referenced by a layer", http.StatusForbidden)
+		return
+	}
+
+	delete(s.layers, k)
+	delete(s.featureTypes, k)
+	w.WriteHeader(http.StatusOK)
+}
+
+// layerKey finds the layer of the request, named either within the workspace
+// of the path or with a workspace prefix
+func (s *Server) layerKey(w http.ResponseWriter, r *http.Request) (key, bool) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return key{}, false
+	}
+
+	name := r.PathValue("layer")
+	if ws == "" {
+		if prefix, local, ok := strings.Cut(name, ":"); ok {
+			ws, name = prefix, local
+		} else {
+			for k := range s.layers {
+				if k.name == name {
+					return k, true
+				}
+			}
+		}
+	}
+
+	k := key{ws, name}
+	if _, ok := s.layers[k]; !ok {
+		notFound(w, "layer", r.PathValue("layer"))
+		return k, false
+	}
+
+	return k, true
+}
+
+func (s *Server) getLayers(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	data := client.Layers{}
+	if ws != "" {
+		for _, name := range sortedNames(s.layers, ws) {
+			data.List = append(data.List, &client.Layer{Name: name})
+		}
+	} else {
+		var names []string
+		for k := range s.layers {
+			names = append(names, k.scope+":"+k.name)
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			data.List = append(data.List, &client.Layer{Name: name})
+		}
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) getLayer(w http.ResponseWriter, r *http.Request) {
+	k, ok := s.layerKey(w, r)
+	if !ok {
+		return
+	}
+
+	writeXML(w, http.StatusOK, s.layers[k])
+}
+
+func (s *Server) updateLayer(w http.ResponseWriter, r *http.Request) {
+	k, ok := s.layerKey(w, r)
+	if !ok {
+		return
+	}
+
+	var layer client.Layer
+	if !readXML(w, r, &layer) {
+		return
+	}
+	layer.Name = k.name
+	if layer.LayerResource.Name == "" {
+		layer.LayerResource = s.layers[k].LayerResource
+	}
+	s.layers[k] = &layer
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteLayer(w http.ResponseWriter, r *http.Request) {
+	k, ok := s.layerKey(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.layers, k)
+	if recurse(r) {
+		delete(s.featureTypes, k)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getLayerGroups(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	data := client.LayerGroups{}
+	for _, name := range sortedNames(s.layerGroups, ws) {
+		data.List = append(data.List, &client.LayerGroup{Name: name})
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) createLayerGroup(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	var layerGroup client.LayerGroup
+	if !readXML(w, r, &layerGroup) {
+		return
+	}
+	k := key{ws, layerGroup.Name}
+	if _, ok := s.layerGroups[k]; ok {
+		conflict(w, "Layer group", layerGroup.Name)
+		return
+	}
+
+	if ws != "" {
+		layerGroup.Workspace = &client.WorkspaceRef{Name: ws}
+	}
+	s.layerGroups[k] = &layerGroup
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) getLayerGroup(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	layerGroup, ok := s.layerGroups[key{ws, r.PathValue("group")}]
+	if !ok {
+		notFound(w, "layer group", r.PathValue("group"))
+		return
+	}
+
+	writeXML(w, http.StatusOK, layerGroup)
+}
+
+func (s *Server) updateLayerGroup(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	k := key{ws, r.PathValue("group")}
+	if _, ok := s.layerGroups[k]; !ok {
+		notFound(w, "layer group", k.name)
+		return
+	}
+
+	var layerGroup client.LayerGroup
+	if !readXML(w, r, &layerGroup) {
+		return
+	}
+	layerGroup.Name = k.name
+	s.layerGroups[k] = &layerGroup
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteLayerGroup(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	k := key{ws, r.PathValue("group")}
+	if _, ok := s.layerGroups[k]; !ok {
+		notFound(w, "layer group", k.name)
+		return
+	}
+
+	delete(s.layerGroups, k)
+	w.WriteHeader(http.StatusOK)
+}
+
+// isStyleInfo tells whether a media type describes the style itself rather
+// than its definition
+func isStyleInfo(mediaType string) bool {
+	return mediaType == "" || strings.HasPrefix(mediaType, "application/xml") || strings.HasPrefix(mediaType, "application/json")
+}
+
+// styleName returns the name given by a style definition
+func styleName(r *http.Request, content string) string {
+	if name := r.URL.Query().Get("name"); name != "" {
+		return name
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(content))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return ""
+		}
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local == "Name" {
+			var name string
+			if decoder.DecodeElement(&name, &start) == nil {
+				return strings.TrimSpace(name)
+			}
+			return ""
+		}
+	}
+}
+
+func (s *Server) getStyles(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	data := client.Styles{}
+	for _, name := range sortedNames(s.styles, ws) {
+		data.List = append(data.List, &client.Style{Name: name})
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) createStyle(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	if !isStyleInfo(r.Header.Get("Content-Type")) {
+		// The style is created, or updated, from its definition
+		content, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		name := styleName(r, string(content))
+		if name == "" {
+			http.Error(w, "Style name not found", http.StatusBadRequest)
+			return
+		}
+
+		k := key{ws, name}
+		if existing, ok := s.styles[k]; ok {
+			existing.content = string(content)
+		} else {
+			s.styles[k] = &style{
+				value:   &client.Style{Name: name, Format: "sld", FileName: name + ".sld"},
+				content: string(content),
+			}
+		}
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	var value client.Style
+	if !readXML(w, r, &value) {
+		return
+	}
+	k := key{ws, value.Name}
+	if _, ok := s.styles[k]; ok {
+		conflict(w, "Style", value.Name)
+		return
+	}
+
+	if ws != "" {
+		value.Workspace = &client.WorkspaceRef{Name: ws}
+	}
+	if value.Format == "" {
+		value.Format = "sld"
+	}
+	if value.FileName == "" {
+		value.FileName = value.Name + "." + value.Format
+	}
+	s.styles[k] = &style{value: &value}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) getStyle(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	name := r.PathValue("style")
+	st, ok := s.styles[key{ws, name}]
+	if !ok {
+		notFound(w, "style", name)
+		return
+	}
+
+	if accept := r.Header.Get("Accept"); !isStyleInfo(accept) {
+		w.Header().Set("Content-Type", accept)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(st.content))
+		return
+	}
+
+	writeXML(w, http.StatusOK, st.value)
+}
+
+func (s *Server) updateStyle(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	k := key{ws, r.PathValue("style")}
+	st, ok := s.styles[k]
+	if !ok {
+		notFound(w, "style", k.name)
+		return
+	}
+
+	if !isStyleInfo(r.Header.Get("Content-Type")) {
+		content, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		st.content = string(content)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	var value client.Style
+	if !readXML(w, r, &value) {
+		return
+	}
+	value.Name = k.name
+	st.value = &value
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteStyle(w http.ResponseWriter, r *http.Request) {
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	k := key{ws, r.PathValue("style")}
+	if _, ok := s.styles[k]; !ok {
+		notFound(w, "style", k.name)
+		return
+	}
+
+	delete(s.styles, k)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) layerRuleIndex(resource string) int {
+	return slices.IndexFunc(s.layerRules, func(rule *client.LayerRule) bool {
+		return rule.Resource == resource
+	})
+}
+
+func (s *Server) getLayerRules(w http.ResponseWriter, r *http.Request) {
+	writeXML(w, http.StatusOK, client.LayerRules{List: s.layerRules})
+}
+
+func (s *Server) createLayerRules(w http.ResponseWriter, r *http.Request) {
+	var data client.LayerRules
+	if !readXML(w, r, &data) {
+		return
+	}
+	for _, rule := range data.List {
+		if s.layerRuleIndex(rule.Resource) != -1 {
+			conflict(w, "Rule", rule.Resource)
+			return
+		}
+	}
+
+	s.layerRules = append(s.layerRules, data.List...)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) updateLayerRules(w http.ResponseWriter, r *http.Request) {
+	var data client.LayerRules
+	if !readXML(w, r, &data) {
+		return
+	}
+	for _, rule := range data.List {
+		if s.layerRuleIndex(rule.Resource) == -1 {
+			http.Error(w, "Unknown rule "+rule.Resource, http.StatusConflict)
+			return
+		}
+	}
+
+	for _, rule := range data.List {
+		s.layerRules[s.layerRuleIndex(rule.Resource)] = rule
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteLayerRule(w http.ResponseWriter, r *http.Request) {
+	idx := s.layerRuleIndex(r.PathValue("rule"))
+	if idx == -1 {
+		notFound(w, "rule", r.PathValue("rule"))
+		return
+	}
+
+	s.layerRules = slices.Delete(s.layerRules, idx, idx+1)
+	w.WriteHeader(http.StatusOK)
+}
+
+func userGroupService(r *http.Request) string {
+	if service := r.PathValue("service"); service != "" {
+		return service
+	}
+
+	return defaultUserGroupService
+}
+
+func (s *Server) getUsers(w http.ResponseWriter, r *http.Request) {
+	service := userGroupService(r)
+
+	data := client.Users{}
+	for _, name := range sortedNames(s.users, service) {
+		data.List = append(data.List, s.users[key{service, name}])
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	var user client.User
+	if !readXML(w, r, &user) {
+		return
+	}
+	k := key{userGroupService(r), user.Name}
+	if _, ok := s.users[k]; ok {
+		conflict(w, "User", user.Name)
+		return
+	}
+
+	s.users[k] = &user
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
+	k := key{userGroupService(r), r.PathValue("user")}
+	if _, ok := s.users[k]; !ok {
+		notFound(w, "user", k.name)
+		return
+	}
+
+	var user client.User
+	if !readXML(w, r, &user) {
+		return
+	}
+	user.Name = k.name
+	s.users[k] = &user
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	k := key{userGroupService(r), r.PathValue("user")}
+	if _, ok := s.users[k]; !ok {
+		notFound(w, "user", k.name)
+		return
+	}
+
+	delete(s.users, k)
+	for _, name := range sortedNames(s.groups, k.scope) {
+		g := key{k.scope, name}
+		s.groups[g] = slices.DeleteFunc(s.groups[g], func(member string) bool { return member == k.name })
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getGroups(w http.ResponseWriter, r *http.Request) {
+	writeXML(w, http.StatusOK, client.UserGroups{List: sortedNames(s.groups, userGroupService(r))})
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	k := key{userGroupService(r), r.PathValue("group")}
+	if _, ok := s.groups[k]; ok {
+		conflict(w, "Group", k.name)
+		return
+	}
+
+	s.groups[k] = nil
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	k := key{userGroupService(r), r.PathValue("group")}
+	if _, ok := s.groups[k]; !ok {
+		notFound(w, "group", k.name)
+		return
+	}
+
+	delete(s.groups, k)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getGroupMembers(w http.ResponseWriter, r *http.Request) {
+	service := userGroupService(r)
+	members, ok := s.groups[key{service, r.PathValue("group")}]
+	if !ok {
+		notFound(w, "group", r.PathValue("group"))
+		return
+	}
+
+	data := client.Users{}
+	for _, name := range members {
+		data.List = append(data.List, s.users[key{service, name}])
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) getUserMemberships(w http.ResponseWriter, r *http.Request) {
+	service := userGroupService(r)
+	user := r.PathValue("user")
+	if _, ok := s.users[key{service, user}]; !ok {
+		notFound(w, "user", user)
+		return
+	}
+
+	data := client.UserGroups{}
+	for _, name := range sortedNames(s.groups, service) {
+		if slices.Contains(s.groups[key{service, name}], user) {
+			data.List = append(data.List, name)
+		}
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) addGroupMember(w http.ResponseWriter, r *http.Request) {
+	user, g, ok := s.groupMember(w, r)
+	if !ok {
+		return
+	}
+
+	if !slices.Contains(s.groups[g], user) {
+		s.groups[g] = append(s.groups[g], user)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) removeGroupMember(w http.ResponseWriter, r *http.Request) {
+	user, g, ok := s.groupMember(w, r)
+	if !ok {
+		return
+	}
+
+	s.groups[g] = slices.DeleteFunc(s.groups[g], func(member string) bool { return member == user })
+	w.WriteHeader(http.StatusOK)
+}
+
+// groupMember looks up the user and the group of a membership request,
+// answering 404 when either does not exist
+func (s *Server) groupMember(w http.ResponseWriter, r *http.Request) (user string, group key, ok bool) {
+	service := userGroupService(r)
+	user = r.PathValue("user")
+	if _, ok := s.users[key{service, user}]; !ok {
+		notFound(w, "user", user)
+		return user, group, false
+	}
+
+	group = key{service, r.PathValue("group")}
+	if _, ok := s.groups[group]; !ok {
+		notFound(w, "group", group.name)
+		return user, group, false
+	}
+
+	return user, group, true
+}
+
+// putRaw stores a GeoWebCache resource as is, answering 201 on creation and
+// 200 on update
+func putRaw(w http.ResponseWriter, r *http.Request, resources map[string][]byte, name string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := xml.Unmarshal(body, new(struct{})); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, exists := resources[name]
+	resources[name] = body
+	if exists {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+func getRaw(w http.ResponseWriter, resources map[string][]byte, kind, name string) {
+	body, ok := resources[name]
+	if !ok {
+		notFound(w, kind, name)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+func deleteRaw(w http.ResponseWriter, resources map[string][]byte, kind, name string) {
+	if _, ok := resources[name]; !ok {
+		notFound(w, kind, name)
+		return
+	}
+
+	delete(resources, name)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getGwcLayers(w http.ResponseWriter, r *http.Request) {
+	data := client.GwcLayers{}
+	for _, name := range slices.Sorted(maps.Keys(s.gwcLayers)) {
+		data.List = append(data.List, &client.GwcLayerReference{Name: name})
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) getGwcLayer(w http.ResponseWriter, r *http.Request) {
+	getRaw(w, s.gwcLayers, "layer", r.PathValue("layer"))
+}
+
+func (s *Server) putGwcLayer(w http.ResponseWriter, r *http.Request) {
+	putRaw(w, r, s.gwcLayers, r.PathValue("layer"))
+}
+
+func (s *Server) deleteGwcLayer(w http.ResponseWriter, r *http.Request) {
+	deleteRaw(w, s.gwcLayers, "layer", r.PathValue("layer"))
+}
+
+func (s *Server) getGridsets(w http.ResponseWriter, r *http.Request) {
+	data := client.Gridsets{}
+	for _, name := range slices.Sorted(maps.Keys(s.gridsets)) {
+		data.List = append(data.List, &client.GridsetReference{Name: name})
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) getGridset(w http.ResponseWriter, r *http.Request) {
+	gridset, ok := s.gridsets[r.PathValue("gridset")]
+	if !ok {
+		notFound(w, "gridset", r.PathValue("gridset"))
+		return
+	}
+
+	writeXML(w, http.StatusOK, gridset)
+}
+
+func (s *Server) putGridset(w http.ResponseWriter, r *http.Request) {
+	var gridset client.Gridset
+	if !readXML(w, r, &gridset) {
+		return
+	}
+	name := r.PathValue("gridset")
+	gridset.Name = name
+
+	_, exists := s.gridsets[name]
+	s.gridsets[name] = &gridset
+	if exists {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+func (s *Server) deleteGridset(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.gridsets[r.PathValue("gridset")]; !ok {
+		notFound(w, "gridset", r.PathValue("gridset"))
+		return
+	}
+
+	delete(s.gridsets, r.PathValue("gridset"))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getBlobstores(w http.ResponseWriter, r *http.Request) {
+	data := client.Blobstores{}
+	for _, name := range slices.Sorted(maps.Keys(s.blobstores)) {
+		data.List = append(data.List, &client.BlobstoreReference{Name: name})
+	}
+
+	writeXML(w, http.StatusOK, data)
+}
+
+func (s *Server) getBlobstore(w http.ResponseWriter, r *http.Request) {
+	getRaw(w, s.blobstores, "blobstore", r.PathValue("blobstore"))
+}
+
+func (s *Server) putBlobstore(w http.ResponseWriter, r *http.Request) {
+	putRaw(w, r, s.blobstores, r.PathValue("blobstore"))
+}
+
+func (s *Server) deleteBlobstore(w http.ResponseWriter, r *http.Request) {
+	deleteRaw(w, s.blobstores, "blobstore", r.PathValue("blobstore"))
+}
+
+func (s *Server) getDiskQuota(w http.ResponseWriter, r *http.Request) {
+	writeXML(w, http.StatusOK, s.diskQuota)
+}
+
+func (s *Server) updateDiskQuota(w http.ResponseWriter, r *http.Request) {
+	var diskQuota client.GwcQuotaConfiguration
+	if !readXML(w, r, &diskQuota) {
+		return
+	}
+
+	s.diskQuota = &diskQuota
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,294 @@
+package geoservertest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/camptocamp/go-geoserver/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkspaces(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	cli := server.Client()
+
+	err := cli.CreateWorkspace(&client.Workspace{Name: "topp"}, false)
+	assert.Nil(t, err)
+
+	err = cli.CreateWorkspace(&client.Workspace{Name: "topp"}, false)
+	assert.NotNil(t, err)
+
+	workspace, err := cli.GetWorkspace("topp")
+	assert.Nil(t, err)
+	assert.Equal(t, "topp", workspace.Name)
+
+	err = cli.UpdateWorkspace("topp", &client.Workspace{Name: "topp", Isolated: true})
+	assert.Nil(t, err)
+
+	workspace, err = cli.GetWorkspace("topp")
+	assert.Nil(t, err)
+	assert.True(t, workspace.Isolated)
+
+	workspaces, err := cli.GetWorkspaces()
+	assert.Nil(t, err)
+	assert.Equal(t, []*client.Workspace{{Name: "topp"}}, workspaces)
+
+	err = cli.DeleteWorkspace("topp", false)
+	assert.Nil(t, err)
+
+	_, err = cli.GetWorkspace("topp")
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+}
+
+func TestNamespaces(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	cli := server.Client()
+
+	err := cli.CreateWorkspaceWithNamespace("sf", "http://www.openplans.org/spearfish", true, false)
+	assert.Nil(t, err)
+
+	namespace, err := cli.GetNamespace("sf")
+	assert.Nil(t, err)
+	assert.Equal(t, "http://www.openplans.org/spearfish", namespace.URI)
+	assert.True(t, namespace.Isolated)
+
+	workspace, err := cli.GetWorkspace("sf")
+	assert.Nil(t, err)
+	assert.True(t, workspace.Isolated)
+
+	err = cli.CreateNamespace(&client.Namespace{Prefix: "topp", URI: "http://www.openplans.org/topp"})
+	assert.Nil(t, err)
+
+	names, err := cli.GetNamespaceNames()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"sf", "topp"}, names)
+
+	err = cli.DeleteNamespace("topp")
+	assert.Nil(t, err)
+
+	_, err = cli.GetWorkspace("topp")
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+}
+
+func TestDatastoresAndFeatureTypes(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	cli := server.Client()
+
+	assert.Nil(t, cli.CreateWorkspace(&client.Workspace{Name: "topp"}, false))
+
+	err := cli.CreateDatastore("topp", &client.Datastore{
+		Name:    "states",
+		Enabled: true,
+		ConnectionParameters: []*client.DatastoreConnectionParameter{
+			{Key: "dbtype", Value: "postgis"},
+		},
+	})
+	assert.Nil(t, err)
+
+	datastore, err := cli.GetDatastore("topp", "states")
+	assert.Nil(t, err)
+	assert.Equal(t, "states", datastore.Name)
+	assert.Equal(t, "topp", datastore.Workspace.Name)
+	assert.Equal(t, "postgis", datastore.ConnectionParameters[0].Value)
+
+	err = cli.CreateFeatureType("topp", "states", &client.FeatureType{Name: "roads", Title: "Roads"})
+	assert.Nil(t, err)
+
+	featureType, err := cli.GetFeatureType("topp", "states", "roads")
+	assert.Nil(t, err)
+	assert.Equal(t, "Roads", featureType.Title)
+
+	featureTypes, err := cli.GetFeatureTypes("topp", "states")
+	assert.Nil(t, err)
+	assert.Len(t, featureTypes, 1)
+
+	layer, err := cli.GetLayer("topp", "roads")
+	assert.Nil(t, err)
+	assert.Equal(t, "topp:roads", layer.LayerResource.Name)
+
+	layers, err := cli.GetLayers("")
+	assert.Nil(t, err)
+	assert.Len(t, layers, 1)
+
+	err = cli.DeleteDatastore("topp", "states", false)
+	assert.True(t, errors.Is(err, client.ErrNotEmpty))
+
+	err = cli.DeleteWorkspace("topp", false)
+	assert.True(t, errors.Is(err, client.ErrNotEmpty))
+
+	err = cli.DeleteWorkspace("topp", true)
+	assert.Nil(t, err)
+
+	_, err = cli.GetLayer("topp", "roads")
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+}
+
+func TestLayerGroups(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	cli := server.Client()
+
+	err := cli.CreateGroup("", &client.LayerGroup{Name: "base", Mode: "SINGLE"})
+	assert.Nil(t, err)
+
+	err = cli.UpdateGroup("", &client.LayerGroup{Name: "base", Mode: "NAMED"})
+	assert.Nil(t, err)
+
+	group, err := cli.GetGroup("", "base")
+	assert.Nil(t, err)
+	assert.Equal(t, "NAMED", group.Mode)
+
+	assert.Nil(t, cli.DeleteGroup("", "base"))
+
+	groups, err := cli.GetGroups("")
+	assert.Nil(t, err)
+	assert.Empty(t, groups)
+}
+
+func TestStyles(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	cli := server.Client()
+
+	style := &client.Style{
+		Name:     "roads",
+		Format:   "sld",
+		Version:  &client.LanguageVersion{Version: "1.0.0"},
+		FileName: "roads.sld",
+	}
+	assert.Nil(t, cli.CreateStyle("", style))
+
+	definition := "<StyledLayerDescriptor><NamedLayer><Name>roads</Name></NamedLayer></StyledLayerDescriptor>"
+	assert.Nil(t, cli.UpdateStyleContent("", style, definition))
+
+	content, err := cli.GetStyleFile("", "roads", "sld", "1.0.0")
+	assert.Nil(t, err)
+	assert.Equal(t, definition, content)
+
+	got, err := cli.GetStyle("", "roads")
+	assert.Nil(t, err)
+	assert.Equal(t, "roads.sld", got.FileName)
+
+	assert.Nil(t, cli.DeleteStyle("", "roads", true, false))
+
+	_, err = cli.GetStyle("", "roads")
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+}
+
+func TestLayerRules(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	cli := server.Client()
+
+	assert.Nil(t, cli.CreateLayerRule(&client.LayerRule{Resource: "topp.*.r", Rule: "ROLE_AUTHENTICATED"}))
+	assert.Nil(t, cli.UpdateLayerRule(&client.LayerRule{Resource: "topp.*.r", Rule: "ADMIN"}))
+
+	rule, err := cli.GetLayerRule("topp.*.r")
+	assert.Nil(t, err)
+	assert.Equal(t, "ADMIN", rule.Rule)
+
+	err = cli.UpdateLayerRule(&client.LayerRule{Resource: "sf.*.r", Rule: "ADMIN"})
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+
+	assert.Nil(t, cli.DeleteLayerRule("topp.*.r"))
+
+	_, err = cli.GetLayerRule("topp.*.r")
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+}
+
+func TestUsers(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	cli := server.Client()
+
+	assert.Nil(t, cli.CreateUser("", &client.User{Name: "alice", Password: "secret", Enabled: true}))
+	assert.Nil(t, cli.UpdateUser("", "alice", &client.User{Password: "changed", Enabled: false}))
+
+	user, err := cli.GetUser("", "alice")
+	assert.Nil(t, err)
+	assert.False(t, user.Enabled)
+
+	_, err = cli.GetUser("other", "alice")
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+
+	assert.Nil(t, cli.DeleteUser("", "alice"))
+
+	_, err = cli.GetUser("", "alice")
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+}
+
+func TestUserGroups(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	cli := server.Client()
+
+	assert.Nil(t, cli.CreateUser("", &client.User{Name: "alice", Password: "secret", Enabled: true}))
+	assert.Nil(t, cli.CreateUserGroup("", "analysts"))
+	assert.True(t, errors.Is(cli.CreateUserGroup("", "analysts"), client.ErrConflict))
+	assert.Nil(t, cli.AddUserToGroup("", "alice", "analysts"))
+
+	groups, err := cli.GetUserMemberships("", "alice")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"analysts"}, groups)
+
+	members, err := cli.GetUserGroupMembers("", "analysts")
+	assert.Nil(t, err)
+	assert.Len(t, members.List, 1)
+	assert.Equal(t, "alice", members.List[0].Name)
+
+	_, err = cli.GetUserGroup("other", "analysts")
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+
+	assert.Nil(t, cli.RemoveUserFromGroup("", "alice", "analysts"))
+	groups, err = cli.GetUserMemberships("", "alice")
+	assert.Nil(t, err)
+	assert.Empty(t, groups)
+
+	assert.Nil(t, cli.DeleteUserGroup("", "analysts"))
+	_, err = cli.GetUserGroup("", "analysts")
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+}
+
+func TestGwc(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	cli := server.Client()
+
+	err := cli.CreateGridset("EPSG:2056", &client.Gridset{Name: "EPSG:2056", TileWidth: 256, TileHeight: 256})
+	assert.Nil(t, err)
+
+	gridset, err := cli.GetGridset("EPSG:2056")
+	assert.Nil(t, err)
+	assert.Equal(t, 256, gridset.TileWidth)
+
+	err = cli.CreateBlobstoreS3("s3", &client.BlobstoreS3{Id: "s3", Bucket: "tiles"})
+	assert.Nil(t, err)
+
+	blobstore, err := cli.GetBlobstoreS3("s3")
+	assert.Nil(t, err)
+	assert.Equal(t, "tiles", blobstore.Bucket)
+
+	err = cli.CreateGwcGsLayer("topp:roads", &client.GwcGsLayer{Name: "topp:roads", BlobStoreId: "s3"})
+	assert.Nil(t, err)
+
+	layer, err := cli.GetGwcGsLayer("topp:roads")
+	assert.Nil(t, err)
+	assert.Equal(t, "s3", layer.BlobStoreId)
+
+	assert.Nil(t, cli.DeleteGwcGsLayer("topp:roads"))
+
+	_, err = cli.GetGwcGsLayer("topp:roads")
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+
+	quota, err := cli.GetGwcQuotaConfiguration()
+	assert.Nil(t, err)
+	quota.Enabled = true
+	assert.Nil(t, cli.UpdateGwcQuotaConfiguration(quota))
+
+	quota, err = cli.GetGwcQuotaConfiguration()
+	assert.Nil(t, err)
+	assert.True(t, quota.Enabled)
+}
@@ -0,0 +1,372 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// GranuleSchema is the list of the attributes of the granules of a
+// structured coverage, such as an ImageMosaic
+type GranuleSchema struct {
+	XMLName    xml.Name                `xml:"Schema"`
+	Attributes []*FeatureTypeAttribute `xml:"attributes>Attribute"`
+}
+
+// Granule is a granule of a structured coverage. Time, Elevation and
+// Location are read from the attributes of the same name, when present,
+// all the attributes being kept in Properties. Time and Elevation are left
+// nil when their value cannot be parsed, which is still in Properties.
+type Granule struct {
+	ID         string
+	Location   string
+	Time       *time.Time
+	Elevation  *float64
+	Geometry   json.RawMessage
+	Properties map[string]any
+}
+
+// GranuleQuery selects granules of a structured coverage
+type GranuleQuery struct {
+	// Filter is a CQL filter on the granule attributes, e.g.
+	// "time >= 2024-01-01T00:00:00Z"
+	Filter string
+	// Offset is the number of granules to skip
+	Offset int
+	// Limit is the maximal number of granules returned, no limit when zero
+	Limit int
+}
+
+// granuleTimeLayouts are the formats GeoServer writes the time attributes with
+var granuleTimeLayouts = []string{
+	"2006-01-02T15:04:05.000-0700",
+	"2006-01-02T15:04:05.000Z0700",
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02",
+}
+
+// parseGranuleTime reads the time attribute of a granule
+func parseGranuleTime(value string) (t time.Time, err error) {
+	for _, layout := range granuleTimeLayouts {
+		if t, err = time.Parse(layout, value); err == nil {
+			return
+		}
+	}
+
+	return t, fmt.Errorf("unexpected granule time %q", value)
+}
+
+// UnmarshalJSON reads a granule from a GeoJSON feature
+func (g *Granule) UnmarshalJSON(data []byte) (err error) {
+	var feature struct {
+		ID         string          `json:"id"`
+		Geometry   json.RawMessage `json:"geometry"`
+		Properties map[string]any  `json:"properties"`
+	}
+	if err = json.Unmarshal(data, &feature); err != nil {
+		return
+	}
+
+	*g = Granule{
+		ID:         feature.ID,
+		Geometry:   feature.Geometry,
+		Properties: feature.Properties,
+	}
+
+	if location, ok := feature.Properties["location"].(string); ok {
+		g.Location = location
+	}
+	if value, ok := feature.Properties["time"].(string); ok {
+		if t, err := parseGranuleTime(value); err == nil {
+			g.Time = &t
+		}
+	}
+	switch elevation := feature.Properties["elevation"].(type) {
+	case float64:
+		g.Elevation = &elevation
+	case string:
+		if value, err := strconv.ParseFloat(elevation, 64); err == nil {
+			g.Elevation = &value
+		}
+	}
+
+	return
+}
+
+// granules is a GeoJSON collection of granules
+type granules struct {
+	Features []*Granule `json:"features"`
+}
+
+// granuleIndexEndpoint returns the endpoint of the index of a structured coverage
+func granuleIndexEndpoint(workspace, coverageStore, coverage string) string {
+	return fmt.Sprintf("/workspaces/%s/coveragestores/%s/coverages/%s/index", workspace, coverageStore, coverage)
+}
+
+// GetGranuleSchema returns the attributes of the granules of a structured coverage
+func (c *Client) GetGranuleSchema(workspace, coverageStore, coverage string) (schema *GranuleSchema, err error) {
+	return c.GetGranuleSchemaContext(context.Background(), workspace, coverageStore, coverage)
+}
+
+// GetGranuleSchemaContext is like GetGranuleSchema but carries ctx down to the HTTP requests
+func (c *Client) GetGranuleSchemaContext(ctx context.Context, workspace, coverageStore, coverage string) (schema *GranuleSchema, err error) {
+	endpoint := granuleIndexEndpoint(workspace, coverageStore, coverage)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data GranuleSchema
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return schema, err
+	}
+
+	schema = &data
+
+	return
+}
+
+// GetGranules returns the granules of a structured coverage matching the query
+func (c *Client) GetGranules(workspace, coverageStore, coverage string, query *GranuleQuery) (granuleList []*Granule, err error) {
+	return c.GetGranulesContext(context.Background(), workspace, coverageStore, coverage, query)
+}
+
+// GetGranulesContext is like GetGranules but carries ctx down to the HTTP requests
+func (c *Client) GetGranulesContext(ctx context.Context, workspace, coverageStore, coverage string, query *GranuleQuery) (granuleList []*Granule, err error) {
+	params := url.Values{}
+	if query != nil {
+		if query.Filter != "" {
+			params.Set("filter", query.Filter)
+		}
+		if query.Offset > 0 {
+			params.Set("offset", strconv.Itoa(query.Offset))
+		}
+		if query.Limit > 0 {
+			params.Set("limit", strconv.Itoa(query.Limit))
+		}
+	}
+
+	endpoint := granuleIndexEndpoint(workspace, coverageStore, coverage) + "/granules.json"
+	if len(params) > 0 {
+		endpoint = endpoint + "?" + params.Encode()
+	}
+
+	statusCode, body, err := c.doFullyTypedRequest(ctx, "GET", endpoint, nil, "", "application/json")
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data granules
+	if err := json.Unmarshal([]byte(body), &data); err != nil {
+		return granuleList, err
+	}
+
+	granuleList = data.Features
+
+	return
+}
+
+// WalkGranules calls fn with the successive pages of at most pageSize
+// granules matching filter, until all have been read or fn returns an error
+func (c *Client) WalkGranules(workspace, coverageStore, coverage, filter string, pageSize int, fn func(granules []*Granule) error) (err error) {
+	return c.WalkGranulesContext(context.Background(), workspace, coverageStore, coverage, filter, pageSize, fn)
+}
+
+// WalkGranulesContext is like WalkGranules but carries ctx down to the HTTP requests
+func (c *Client) WalkGranulesContext(ctx context.Context, workspace, coverageStore, coverage, filter string, pageSize int, fn func(granules []*Granule) error) (err error) {
+	if pageSize <= 0 {
+		return fmt.Errorf("page size must be positive")
+	}
+
+	for offset := 0; ; offset += pageSize {
+		page, err := c.GetGranulesContext(ctx, workspace, coverageStore, coverage, &GranuleQuery{
+			Filter: filter,
+			Offset: offset,
+			Limit:  pageSize,
+		})
+		if err != nil {
+			return err
+		}
+		if len(page) > 0 {
+			if err := fn(page); err != nil {
+				return err
+			}
+		}
+		if len(page) < pageSize {
+			return nil
+		}
+	}
+}
+
+// GetGranule returns a single granule of a structured coverage
+func (c *Client) GetGranule(workspace, coverageStore, coverage, granuleID string) (granule *Granule, err error) {
+	return c.GetGranuleContext(context.Background(), workspace, coverageStore, coverage, granuleID)
+}
+
+// GetGranuleContext is like GetGranule but carries ctx down to the HTTP requests
+func (c *Client) GetGranuleContext(ctx context.Context, workspace, coverageStore, coverage, granuleID string) (granule *Granule, err error) {
+	endpoint := fmt.Sprintf("%s/granules/%s.json", granuleIndexEndpoint(workspace, coverageStore, coverage), granuleID)
+	statusCode, body, err := c.doFullyTypedRequest(ctx, "GET", endpoint, nil, "", "application/json")
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	// Depending on the version, the granule is returned alone or in a
+	// collection
+	var data struct {
+		Type     string     `json:"type"`
+		Features []*Granule `json:"features"`
+	}
+	if err := json.Unmarshal([]byte(body), &data); err != nil {
+		return granule, err
+	}
+
+	if data.Type == "Feature" {
+		var single Granule
+		if err := json.Unmarshal([]byte(body), &single); err != nil {
+			return granule, err
+		}
+		return &single, nil
+	}
+
+	if len(data.Features) == 0 {
+		err = newAPIError("GET", endpoint, 404, body, ErrNotFound)
+		return
+	}
+
+	granule = data.Features[0]
+
+	return
+}
+
+// DeleteGranules removes the granules of a structured coverage matching the
+// CQL filter. purge tells what to remove from the disk along with the index
+// entries: "none", "metadata" or "all". It is left to the GeoServer default
+// when empty.
+func (c *Client) DeleteGranules(workspace, coverageStore, coverage, filter, purge string) (err error) {
+	return c.DeleteGranulesContext(context.Background(), workspace, coverageStore, coverage, filter, purge)
+}
+
+// DeleteGranulesContext is like DeleteGranules but carries ctx down to the HTTP requests
+func (c *Client) DeleteGranulesContext(ctx context.Context, workspace, coverageStore, coverage, filter, purge string) (err error) {
+	if filter == "" {
+		return fmt.Errorf("filter cannot be null, use INCLUDE to remove all the granules")
+	}
+
+	params := url.Values{}
+	params.Set("filter", filter)
+	if purge != "" {
+		params.Set("purge", purge)
+	}
+
+	endpoint := granuleIndexEndpoint(workspace, coverageStore, coverage) + "/granules?" + params.Encode()
+
+	return c.deleteGranules(ctx, endpoint)
+}
+
+// DeleteGranule removes a single granule of a structured coverage
+func (c *Client) DeleteGranule(workspace, coverageStore, coverage, granuleID, purge string) (err error) {
+	return c.DeleteGranuleContext(context.Background(), workspace, coverageStore, coverage, granuleID, purge)
+}
+
+// DeleteGranuleContext is like DeleteGranule but carries ctx down to the HTTP requests
+func (c *Client) DeleteGranuleContext(ctx context.Context, workspace, coverageStore, coverage, granuleID, purge string) (err error) {
+	endpoint := fmt.Sprintf("%s/granules/%s", granuleIndexEndpoint(workspace, coverageStore, coverage), granuleID)
+	if purge != "" {
+		endpoint = endpoint + "?purge=" + url.QueryEscape(purge)
+	}
+
+	return c.deleteGranules(ctx, endpoint)
+}
+
+// deleteGranules removes the granules targeted by the endpoint
+func (c *Client) deleteGranules(ctx context.Context, endpoint string) (err error) {
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// HarvestGranules adds granules to an ImageMosaic store. With the
+// UploadFile method, data is a zip of granules; with UploadExternal, it is
+// the path of a file or a directory on the GeoServer host.
+func (c *Client) HarvestGranules(workspace, coverageStore string, data io.Reader, options *UploadOptions) (err error) {
+	return c.HarvestGranulesContext(context.Background(), workspace, coverageStore, data, options)
+}
+
+// HarvestGranulesContext is like HarvestGranules but carries ctx down to the HTTP requests
+func (c *Client) HarvestGranulesContext(ctx context.Context, workspace, coverageStore string, data io.Reader, options *UploadOptions) (err error) {
+	return c.upload(ctx, "POST", fmt.Sprintf("/workspaces/%s/coveragestores/%s", workspace, coverageStore), "imagemosaic", data, options)
+}
+
+// HarvestGranulesFile is like HarvestGranules but streams the file at path
+func (c *Client) HarvestGranulesFile(workspace, coverageStore, path string, options *UploadOptions) (err error) {
+	return c.HarvestGranulesFileContext(context.Background(), workspace, coverageStore, path, options)
+}
+
+// HarvestGranulesFileContext is like HarvestGranulesFile but carries ctx down to the HTTP requests
+func (c *Client) HarvestGranulesFileContext(ctx context.Context, workspace, coverageStore, path string, options *UploadOptions) (err error) {
+	return c.uploadPath(ctx, "POST", fmt.Sprintf("/workspaces/%s/coveragestores/%s", workspace, coverageStore), "imagemosaic", path, options)
+}
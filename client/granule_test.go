@@ -0,0 +1,257 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const granulesPath = "/workspaces/nurc/coveragestores/sst/coverages/sst/index/granules"
+
+func granuleFeature(id int) string {
+	return fmt.Sprintf(`{
+		"type": "Feature",
+		"id": "sst.%d",
+		"geometry": {"type": "Polygon", "coordinates": [[[0, 0], [10, 0], [10, 10], [0, 0]]]},
+		"properties": {
+			"location": "sst_%d.tif",
+			"time": "2024-01-0%dT00:00:00.000+0000",
+			"elevation": %d
+		}
+	}`, id, id, id, id*10)
+}
+
+func TestGetGranuleSchemaSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/workspaces/nurc/coveragestores/sst/coverages/sst/index")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<Schema>
+			<attributes>
+				<Attribute>
+					<name>the_geom</name>
+					<minOccurs>0</minOccurs>
+					<maxOccurs>1</maxOccurs>
+					<nillable>true</nillable>
+					<binding>org.locationtech.jts.geom.Polygon</binding>
+				</Attribute>
+				<Attribute>
+					<name>location</name>
+					<minOccurs>0</minOccurs>
+					<maxOccurs>1</maxOccurs>
+					<nillable>true</nillable>
+					<binding>java.lang.String</binding>
+				</Attribute>
+			</attributes>
+		</Schema>
+		`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	schema, err := cli.GetGranuleSchema("nurc", "sst", "sst")
+
+	assert.Nil(t, err)
+	assert.Len(t, schema.Attributes, 2)
+	assert.Equal(t, &FeatureTypeAttribute{
+		Name:      "location",
+		MinOccurs: 0,
+		MaxOccurs: 1,
+		Nillable:  true,
+		Binding:   "java.lang.String",
+	}, schema.Attributes[1])
+}
+
+func TestGetGranulesSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, granulesPath+".json")
+		assert.Equal(t, r.URL.Query().Get("filter"), "time >= 2024-01-01")
+		assert.Equal(t, r.URL.Query().Get("offset"), "10")
+		assert.Equal(t, r.URL.Query().Get("limit"), "5")
+		assert.Empty(t, r.Header.Get("Content-Type"))
+
+		w.WriteHeader(200)
+		w.Write([]byte(`{"type": "FeatureCollection", "features": [` + granuleFeature(1) + `]}`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	granules, err := cli.GetGranules("nurc", "sst", "sst", &GranuleQuery{
+		Filter: "time >= 2024-01-01",
+		Offset: 10,
+		Limit:  5,
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, granules, 1)
+	assert.Equal(t, "sst.1", granules[0].ID)
+	assert.Equal(t, "sst_1.tif", granules[0].Location)
+	assert.True(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Equal(*granules[0].Time))
+	assert.Equal(t, 10.0, *granules[0].Elevation)
+	assert.Equal(t, "sst_1.tif", granules[0].Properties["location"])
+	assert.Contains(t, string(granules[0].Geometry), `"Polygon"`)
+}
+
+func TestGetGranulesUnparseableTime(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		w.Write([]byte(`{"type": "FeatureCollection", "features": [` +
+			`{"type": "Feature", "id": "sst.1", "properties": {"location": "sst_1.tif", "time": "last summer"}},` +
+			granuleFeature(2) + `]}`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	granules, err := cli.GetGranules("nurc", "sst", "sst", nil)
+
+	assert.Nil(t, err)
+	assert.Len(t, granules, 2)
+	assert.Nil(t, granules[0].Time)
+	assert.Equal(t, "last summer", granules[0].Properties["time"])
+	assert.NotNil(t, granules[1].Time)
+}
+
+func TestWalkGranules(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		assert.Equal(t, r.URL.Query().Get("limit"), "2")
+
+		// 3 granules in the index
+		var features []string
+		for id := offset + 1; id <= 3 && id <= offset+2; id++ {
+			features = append(features, granuleFeature(id))
+		}
+
+		w.WriteHeader(200)
+		w.Write([]byte(`{"type": "FeatureCollection", "features": [` + strings.Join(features, ",") + `]}`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	var pages [][]string
+	err := cli.WalkGranules("nurc", "sst", "sst", "", 2, func(granules []*Granule) error {
+		var ids []string
+		for _, granule := range granules {
+			ids = append(ids, granule.ID)
+		}
+		pages = append(pages, ids)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"sst.1", "sst.2"}, {"sst.3"}}, pages)
+}
+
+func TestGetGranuleSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, granulesPath+"/sst.2.json")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`{"type": "FeatureCollection", "features": [` + granuleFeature(2) + `]}`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	granule, err := cli.GetGranule("nurc", "sst", "sst", "sst.2")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "sst_2.tif", granule.Location)
+}
+
+func TestDeleteGranulesSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.Path, granulesPath)
+		assert.Equal(t, r.URL.Query().Get("filter"), "location LIKE 'sst_1%'")
+		assert.Equal(t, r.URL.Query().Get("purge"), "all")
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteGranules("nurc", "sst", "sst", "location LIKE 'sst_1%'", "all")
+	assert.Nil(t, err)
+
+	err = cli.DeleteGranules("nurc", "sst", "sst", "", "")
+	assert.NotNil(t, err)
+}
+
+func TestDeleteGranuleSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.Path, granulesPath+"/sst.1")
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteGranule("nurc", "sst", "sst", "sst.1", "")
+
+	assert.Nil(t, err)
+}
+
+func TestHarvestGranulesExternal(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/workspaces/nurc/coveragestores/sst/external.imagemosaic")
+		assert.Equal(t, r.Header.Get("Content-Type"), "text/plain")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, "/data/sst/2024", string(rawBody))
+
+		w.WriteHeader(202)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.HarvestGranules("nurc", "sst", strings.NewReader("/data/sst/2024"), &UploadOptions{
+		Method: UploadExternal,
+	})
+
+	assert.Nil(t, err)
+}
@@ -2,8 +2,8 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
-	"fmt"
 )
 
 type GwcQuota struct {
@@ -30,7 +30,13 @@ type GwcQuotaConfiguration struct {
 
 // GetGwcQuotaConfiguration return the GeoWebCache Quota Configuration of the instance
 func (c *Client) GetGwcQuotaConfiguration() (gwcQuotCfg *GwcQuotaConfiguration, err error) {
-	statusCode, body, err := c.doRequest("GET", "/diskquota.xml", nil)
+	return c.GetGwcQuotaConfigurationContext(context.Background())
+}
+
+// GetGwcQuotaConfigurationContext is like GetGwcQuotaConfiguration but carries ctx down to the HTTP requests
+func (c *Client) GetGwcQuotaConfigurationContext(ctx context.Context) (gwcQuotCfg *GwcQuotaConfiguration, err error) {
+	endpoint := "/diskquota.xml"
+	statusCode, body, err := c.doGwcRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -39,7 +45,7 @@ func (c *Client) GetGwcQuotaConfiguration() (gwcQuotCfg *GwcQuotaConfiguration,
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -55,27 +61,33 @@ func (c *Client) GetGwcQuotaConfiguration() (gwcQuotCfg *GwcQuotaConfiguration,
 
 // UpdateGwcQuotaConfiguration updates the disk quota configuration of GeoWebCache
 func (c *Client) UpdateGwcQuotaConfiguration(gwcQuotCfg *GwcQuotaConfiguration) (err error) {
+	return c.UpdateGwcQuotaConfigurationContext(context.Background(), gwcQuotCfg)
+}
+
+// UpdateGwcQuotaConfigurationContext is like UpdateGwcQuotaConfiguration but carries ctx down to the HTTP requests
+func (c *Client) UpdateGwcQuotaConfigurationContext(ctx context.Context, gwcQuotCfg *GwcQuotaConfiguration) (err error) {
 	payload, _ := xml.Marshal(&gwcQuotCfg)
 
-	statusCode, body, err := c.doRequest("PUT", "/diskquota.xml", bytes.NewBuffer(payload))
+	endpoint := "/diskquota.xml"
+	statusCode, body, err := c.doGwcRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -17,22 +18,28 @@ type BlobstoreFile struct {
 
 // GetBlobstoreFile return a single File datastore based on its name
 func (c *Client) GetBlobstoreFile(name string) (blobstore *BlobstoreFile, err error) {
-	statusCode, body, err := c.doRequest("GET", fmt.Sprintf("/blobstores/%s", name), nil)
+	return c.GetBlobstoreFileContext(context.Background(), name)
+}
+
+// GetBlobstoreFileContext is like GetBlobstoreFile but carries ctx down to the HTTP requests
+func (c *Client) GetBlobstoreFileContext(ctx context.Context, name string) (blobstore *BlobstoreFile, err error) {
+	endpoint := fmt.Sprintf("/blobstores/%s", name)
+	statusCode, body, err := c.doGwcRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -48,77 +55,95 @@ func (c *Client) GetBlobstoreFile(name string) (blobstore *BlobstoreFile, err er
 
 // CreateBlobstoreFile creates a blobstore on disk
 func (c *Client) CreateBlobstoreFile(blobstoreName string, blobstore *BlobstoreFile) (err error) {
+	return c.CreateBlobstoreFileContext(context.Background(), blobstoreName, blobstore)
+}
+
+// CreateBlobstoreFileContext is like CreateBlobstoreFile but carries ctx down to the HTTP requests
+func (c *Client) CreateBlobstoreFileContext(ctx context.Context, blobstoreName string, blobstore *BlobstoreFile) (err error) {
 	payload, _ := xml.Marshal(&blobstore)
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/blobstores/%s", blobstoreName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/blobstores/%s", blobstoreName)
+	statusCode, body, err := c.doGwcRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateBlobstoreFile updates a blobstore
 func (c *Client) UpdateBlobstoreFile(blobstoreName string, blobstore *BlobstoreFile) (err error) {
+	return c.UpdateBlobstoreFileContext(context.Background(), blobstoreName, blobstore)
+}
+
+// UpdateBlobstoreFileContext is like UpdateBlobstoreFile but carries ctx down to the HTTP requests
+func (c *Client) UpdateBlobstoreFileContext(ctx context.Context, blobstoreName string, blobstore *BlobstoreFile) (err error) {
 	payload, _ := xml.Marshal(&blobstore)
 
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/blobstores/%s", blobstoreName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/blobstores/%s", blobstoreName)
+	statusCode, body, err := c.doGwcRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteDatastore deletes a datastore
 func (c *Client) DeleteBlobstoreFile(blobstoreName string) (err error) {
-	statusCode, body, err := c.doRequest("DELETE", fmt.Sprintf("/blobstores/%s", blobstoreName), nil)
+	return c.DeleteBlobstoreFileContext(context.Background(), blobstoreName)
+}
+
+// DeleteBlobstoreFileContext is like DeleteBlobstoreFile but carries ctx down to the HTTP requests
+func (c *Client) DeleteBlobstoreFileContext(ctx context.Context, blobstoreName string) (err error) {
+	endpoint := fmt.Sprintf("/blobstores/%s", blobstoreName)
+	statusCode, body, err := c.doGwcRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -25,24 +26,30 @@ type GwcGsLayer struct {
 
 // GetGridset return a single Gridset based on its name
 func (c *Client) GetGwcGsLayer(name string) (layer *GwcGsLayer, err error) {
-	statusCode, body, err := c.doRequest("GET", fmt.Sprintf("/layers/%s", name), nil)
+	return c.GetGwcGsLayerContext(context.Background(), name)
+}
+
+// GetGwcGsLayerContext is like GetGwcGsLayer but carries ctx down to the HTTP requests
+func (c *Client) GetGwcGsLayerContext(ctx context.Context, name string) (layer *GwcGsLayer, err error) {
+	endpoint := fmt.Sprintf("/layers/%s", name)
+	statusCode, body, err := c.doGwcRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	case 201:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -58,77 +65,95 @@ func (c *Client) GetGwcGsLayer(name string) (layer *GwcGsLayer, err error) {
 
 // CreateGwcWmsLayer creates a GWC GS Layer
 func (c *Client) CreateGwcGsLayer(layerName string, layer *GwcGsLayer) (err error) {
+	return c.CreateGwcGsLayerContext(context.Background(), layerName, layer)
+}
+
+// CreateGwcGsLayerContext is like CreateGwcGsLayer but carries ctx down to the HTTP requests
+func (c *Client) CreateGwcGsLayerContext(ctx context.Context, layerName string, layer *GwcGsLayer) (err error) {
 	payload, _ := xml.Marshal(&layer)
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/layers/%s", layerName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/layers/%s", layerName)
+	statusCode, body, err := c.doGwcRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateGwcWmsLayer updates a GWC GS layer
 func (c *Client) UpdateGwcGsLayer(layerName string, layer *GwcGsLayer) (err error) {
+	return c.UpdateGwcGsLayerContext(context.Background(), layerName, layer)
+}
+
+// UpdateGwcGsLayerContext is like UpdateGwcGsLayer but carries ctx down to the HTTP requests
+func (c *Client) UpdateGwcGsLayerContext(ctx context.Context, layerName string, layer *GwcGsLayer) (err error) {
 	payload, _ := xml.Marshal(&layer)
 
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/layers/%s", layerName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/layers/%s", layerName)
+	statusCode, body, err := c.doGwcRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteGridset deletes a gridset
 func (c *Client) DeleteGwcGsLayer(layerName string) (err error) {
-	statusCode, body, err := c.doRequest("DELETE", fmt.Sprintf("/layers/%s", layerName), nil)
+	return c.DeleteGwcGsLayerContext(context.Background(), layerName)
+}
+
+// DeleteGwcGsLayerContext is like DeleteGwcGsLayer but carries ctx down to the HTTP requests
+func (c *Client) DeleteGwcGsLayerContext(ctx context.Context, layerName string) (err error) {
+	endpoint := fmt.Sprintf("/layers/%s", layerName)
+	statusCode, body, err := c.doGwcRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
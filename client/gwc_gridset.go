@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -51,37 +52,54 @@ type ScaleDenominators struct {
 
 // GetGridsets returns all the gridsets
 func (c *Client) GetGridsets() (gridsets []*Gridset, err error) {
+	return c.GetGridsetsContext(context.Background())
+}
+
+// GetGridsetsContext is like GetGridsets but carries ctx down to the HTTP requests
+func (c *Client) GetGridsetsContext(ctx context.Context) (gridsets []*Gridset, err error) {
+	names, err := c.GetGridsetNamesContext(ctx)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*Gridset, error) {
+		return c.GetGridsetContext(ctx, name)
+	})
+}
+
+// GetGridsetNames returns the names of the gridsets, without fetching them
+func (c *Client) GetGridsetNames() (names []string, err error) {
+	return c.GetGridsetNamesContext(context.Background())
+}
+
+// GetGridsetNamesContext is like GetGridsetNames but carries ctx down to the HTTP requests
+func (c *Client) GetGridsetNamesContext(ctx context.Context) (names []string, err error) {
 	var endpoint string = "/gridsets"
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doGwcRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
 	var data Gridsets
 
 	if err := xml.Unmarshal([]byte(body), &data); err != nil {
-		return gridsets, err
+		return names, err
 	}
 
 	for _, gridsetRef := range data.List {
-		gridset, err := c.GetGridset(gridsetRef.Name)
-		if err != nil {
-			return gridsets, err
-		}
-
-		gridsets = append(gridsets, gridset)
+		names = append(names, gridsetRef.Name)
 	}
 
 	return
@@ -89,22 +107,28 @@ func (c *Client) GetGridsets() (gridsets []*Gridset, err error) {
 
 // GetGridset return a single Gridset based on its name
 func (c *Client) GetGridset(name string) (gridset *Gridset, err error) {
-	statusCode, body, err := c.doRequest("GET", fmt.Sprintf("/gridsets/%s", name), nil)
+	return c.GetGridsetContext(context.Background(), name)
+}
+
+// GetGridsetContext is like GetGridset but carries ctx down to the HTTP requests
+func (c *Client) GetGridsetContext(ctx context.Context, name string) (gridset *Gridset, err error) {
+	endpoint := fmt.Sprintf("/gridsets/%s", name)
+	statusCode, body, err := c.doGwcRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -120,77 +144,95 @@ func (c *Client) GetGridset(name string) (gridset *Gridset, err error) {
 
 // CreateGridset creates a Gridset
 func (c *Client) CreateGridset(gridsetName string, gridset *Gridset) (err error) {
+	return c.CreateGridsetContext(context.Background(), gridsetName, gridset)
+}
+
+// CreateGridsetContext is like CreateGridset but carries ctx down to the HTTP requests
+func (c *Client) CreateGridsetContext(ctx context.Context, gridsetName string, gridset *Gridset) (err error) {
 	payload, _ := xml.Marshal(&gridset)
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/gridsets/%s", gridsetName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/gridsets/%s", gridsetName)
+	statusCode, body, err := c.doGwcRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateGridset updates a gridset
 func (c *Client) UpdateGridset(gridsetName string, gridset *Gridset) (err error) {
+	return c.UpdateGridsetContext(context.Background(), gridsetName, gridset)
+}
+
+// UpdateGridsetContext is like UpdateGridset but carries ctx down to the HTTP requests
+func (c *Client) UpdateGridsetContext(ctx context.Context, gridsetName string, gridset *Gridset) (err error) {
 	payload, _ := xml.Marshal(&gridset)
 
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/gridsets/%s", gridsetName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/gridsets/%s", gridsetName)
+	statusCode, body, err := c.doGwcRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteGridset deletes a gridset
 func (c *Client) DeleteGridset(gridsetName string) (err error) {
-	statusCode, body, err := c.doRequest("DELETE", fmt.Sprintf("/gridsets/%s", gridsetName), nil)
+	return c.DeleteGridsetContext(context.Background(), gridsetName)
+}
+
+// DeleteGridsetContext is like DeleteGridset but carries ctx down to the HTTP requests
+func (c *Client) DeleteGridsetContext(ctx context.Context, gridsetName string) (err error) {
+	endpoint := fmt.Sprintf("/gridsets/%s", gridsetName)
+	statusCode, body, err := c.doGwcRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
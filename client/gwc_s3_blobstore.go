@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -25,22 +26,28 @@ type BlobstoreS3 struct {
 
 // GetBlobstoreS3 return a single S3 datastore based on its name
 func (c *Client) GetBlobstoreS3(name string) (blobstore *BlobstoreS3, err error) {
-	statusCode, body, err := c.doRequest("GET", fmt.Sprintf("/blobstores/%s", name), nil)
+	return c.GetBlobstoreS3Context(context.Background(), name)
+}
+
+// GetBlobstoreS3Context is like GetBlobstoreS3 but carries ctx down to the HTTP requests
+func (c *Client) GetBlobstoreS3Context(ctx context.Context, name string) (blobstore *BlobstoreS3, err error) {
+	endpoint := fmt.Sprintf("/blobstores/%s", name)
+	statusCode, body, err := c.doGwcRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -56,75 +63,93 @@ func (c *Client) GetBlobstoreS3(name string) (blobstore *BlobstoreS3, err error)
 
 // CreateBlobstoreS3 creates a blobstore on S3
 func (c *Client) CreateBlobstoreS3(blobstoreName string, blobstore *BlobstoreS3) (err error) {
+	return c.CreateBlobstoreS3Context(context.Background(), blobstoreName, blobstore)
+}
+
+// CreateBlobstoreS3Context is like CreateBlobstoreS3 but carries ctx down to the HTTP requests
+func (c *Client) CreateBlobstoreS3Context(ctx context.Context, blobstoreName string, blobstore *BlobstoreS3) (err error) {
 	payload, _ := xml.Marshal(&blobstore)
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/blobstores/%s", blobstoreName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/blobstores/%s", blobstoreName)
+	statusCode, body, err := c.doGwcRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateBlobstoreS3 updates a blobstore
 func (c *Client) UpdateBlobstoreS3(blobstoreName string, blobstore *BlobstoreS3) (err error) {
+	return c.UpdateBlobstoreS3Context(context.Background(), blobstoreName, blobstore)
+}
+
+// UpdateBlobstoreS3Context is like UpdateBlobstoreS3 but carries ctx down to the HTTP requests
+func (c *Client) UpdateBlobstoreS3Context(ctx context.Context, blobstoreName string, blobstore *BlobstoreS3) (err error) {
 	payload, _ := xml.Marshal(&blobstore)
 
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/blobstores/%s", blobstoreName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/blobstores/%s", blobstoreName)
+	statusCode, body, err := c.doGwcRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteDatastore deletes a datastore
 func (c *Client) DeleteBlobstoreS3(blobstoreName string) (err error) {
-	statusCode, body, err := c.doRequest("DELETE", fmt.Sprintf("/blobstores/%s", blobstoreName), nil)
+	return c.DeleteBlobstoreS3Context(context.Background(), blobstoreName)
+}
+
+// DeleteBlobstoreS3Context is like DeleteBlobstoreS3 but carries ctx down to the HTTP requests
+func (c *Client) DeleteBlobstoreS3Context(ctx context.Context, blobstoreName string) (err error) {
+	endpoint := fmt.Sprintf("/blobstores/%s", blobstoreName)
+	statusCode, body, err := c.doGwcRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
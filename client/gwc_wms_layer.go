@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -41,24 +42,30 @@ type GwcWmsLayer struct {
 
 // GetGridset return a single Gridset based on its name
 func (c *Client) GetGwcWMSLayer(name string) (layer *GwcWmsLayer, err error) {
-	statusCode, body, err := c.doRequest("GET", fmt.Sprintf("/layers/%s", name), nil)
+	return c.GetGwcWMSLayerContext(context.Background(), name)
+}
+
+// GetGwcWMSLayerContext is like GetGwcWMSLayer but carries ctx down to the HTTP requests
+func (c *Client) GetGwcWMSLayerContext(ctx context.Context, name string) (layer *GwcWmsLayer, err error) {
+	endpoint := fmt.Sprintf("/layers/%s", name)
+	statusCode, body, err := c.doGwcRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	case 201:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -74,77 +81,95 @@ func (c *Client) GetGwcWMSLayer(name string) (layer *GwcWmsLayer, err error) {
 
 // CreateGwcWmsLayer creates a GWC WMS Layer
 func (c *Client) CreateGwcWmsLayer(layerName string, layer *GwcWmsLayer) (err error) {
+	return c.CreateGwcWmsLayerContext(context.Background(), layerName, layer)
+}
+
+// CreateGwcWmsLayerContext is like CreateGwcWmsLayer but carries ctx down to the HTTP requests
+func (c *Client) CreateGwcWmsLayerContext(ctx context.Context, layerName string, layer *GwcWmsLayer) (err error) {
 	payload, _ := xml.Marshal(&layer)
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/layers/%s", layerName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/layers/%s", layerName)
+	statusCode, body, err := c.doGwcRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateGwcWmsLayer updates a GWC wms layer
 func (c *Client) UpdateGwcWmsLayer(layerName string, layer *GwcWmsLayer) (err error) {
+	return c.UpdateGwcWmsLayerContext(context.Background(), layerName, layer)
+}
+
+// UpdateGwcWmsLayerContext is like UpdateGwcWmsLayer but carries ctx down to the HTTP requests
+func (c *Client) UpdateGwcWmsLayerContext(ctx context.Context, layerName string, layer *GwcWmsLayer) (err error) {
 	payload, _ := xml.Marshal(&layer)
 
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/layers/%s", layerName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/layers/%s", layerName)
+	statusCode, body, err := c.doGwcRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteGridset deletes a gridset
 func (c *Client) DeleteGwcWmsLayer(layerName string) (err error) {
-	statusCode, body, err := c.doRequest("DELETE", fmt.Sprintf("/layers/%s", layerName), nil)
+	return c.DeleteGwcWmsLayerContext(context.Background(), layerName)
+}
+
+// DeleteGwcWmsLayerContext is like DeleteGwcWmsLayer but carries ctx down to the HTTP requests
+func (c *Client) DeleteGwcWmsLayerContext(ctx context.Context, layerName string) (err error) {
+	endpoint := fmt.Sprintf("/layers/%s", layerName)
+	statusCode, body, err := c.doGwcRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
@@ -0,0 +1,265 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// APIs a Call can be sent to
+const (
+	APIRest = "rest"
+	APIGwc  = "gwc"
+)
+
+// Call describes a request sent to GeoServer, as seen by the interceptors
+type Call struct {
+	// API is either APIRest or APIGwc
+	API    string
+	Method string
+	// Path is relative to the base URL of the API and includes the query
+	Path string
+	// Resource is the kind of resource targeted, e.g. workspaces/datastores
+	Resource    string
+	ContentType string
+	Accept      string
+	Body        io.Reader
+}
+
+// Result is the answer of GeoServer to a Call
+type Result struct {
+	StatusCode int
+	Body       string
+}
+
+// Handler sends a Call to GeoServer
+type Handler func(ctx context.Context, call *Call) (*Result, error)
+
+// Interceptor wraps the sending of a Call. It must call next to go on with
+// the call and may alter the context, the call or the result.
+type Interceptor func(ctx context.Context, call *Call, next Handler) (*Result, error)
+
+// PeekBody returns the body of the call without consuming it. It returns
+// false when the body is streamed and cannot be read twice.
+func (call *Call) PeekBody() ([]byte, bool) {
+	switch body := call.Body.(type) {
+	case nil:
+		return nil, true
+	case *bytes.Buffer:
+		return body.Bytes(), true
+	case *bytes.Reader:
+		data := make([]byte, body.Len())
+		_, err := body.ReadAt(data, body.Size()-int64(body.Len()))
+		return data, err == nil || err == io.EOF
+	case *strings.Reader:
+		data := make([]byte, body.Len())
+		_, err := body.ReadAt(data, body.Size()-int64(body.Len()))
+		return data, err == nil || err == io.EOF
+	default:
+		return nil, false
+	}
+}
+
+// resourceSegments are the fixed segments of the paths of the APIs. Any other
+// segment is taken for the name of a resource and left out of the labels, so
+// that names never end up in metrics, including for endpoints missing here.
+var resourceSegments = map[string]bool{
+	// Catalog
+	"workspaces":     true,
+	"namespaces":     true,
+	"datastores":     true,
+	"featuretypes":   true,
+	"coveragestores": true,
+	"coverages":      true,
+	"index":          true,
+	"granules":       true,
+	"wmsstores":      true,
+	"wmslayers":      true,
+	"wmtsstores":     true,
+	"layers":         true,
+	"layergroups":    true,
+	"styles":         true,
+	"resource":       true,
+	// Services and settings
+	"services": true,
+	"wms":      true,
+	"wfs":      true,
+	"wcs":      true,
+	"wmts":     true,
+	"wps":      true,
+	"settings": true,
+	"contact":  true,
+	// Security
+	"security":          true,
+	"usergroup":         true,
+	"service":           true,
+	"users":             true,
+	"user":              true,
+	"groups":            true,
+	"group":             true,
+	"roles":             true,
+	"role":              true,
+	"acl":               true,
+	"rest":              true,
+	"catalog":           true,
+	"authproviders":     true,
+	"authfilters":       true,
+	"filterChain":       true,
+	"order":             true,
+	"usergroupservices": true,
+	"roleservices":      true,
+	"passwordpolicies":  true,
+	"activeroleservice": true,
+	"masterpw":          true,
+	"self":              true,
+	"password":          true,
+	"urlchecks":         true,
+	// GeoWebCache
+	"blobstores": true,
+	"gridsets":   true,
+	"diskquota":  true,
+}
+
+// uploadMethods are the methods of the upload endpoints, e.g. file.shp
+var uploadMethods = []string{"file", "url", "external"}
+
+// resourceOf computes the kind of resource targeted by a path, leaving out
+// the names so that it can be used as a metric label
+func resourceOf(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var kind []string
+	for _, segment := range segments {
+		// Segments may be requested with a format extension, e.g.
+		// granules.json
+		name := strings.TrimSuffix(strings.TrimSuffix(segment, ".json"), ".xml")
+		method, _, _ := strings.Cut(segment, ".")
+
+		switch {
+		case resourceSegments[name]:
+			kind = append(kind, segment)
+		case len(kind) > 0 && slices.Contains(uploadMethods, method):
+			kind = append(kind, method)
+		}
+
+		// Resources are files whose paths are made of names
+		if segment == "resource" {
+			break
+		}
+	}
+
+	return strings.Join(kind, "/")
+}
+
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(<entry key="(?:passwd|password|Password)">)[^<]*(</entry>)`),
+	regexp.MustCompile(`(<(?:awsSecretKey|password|passwd|secretKey|oldMasterPassword|newMasterPassword|newPassword)>)[^<]*(</(?:awsSecretKey|password|passwd|secretKey|oldMasterPassword|newMasterPassword|newPassword)>)`),
+	regexp.MustCompile(`("(?:awsSecretKey|password|passwd)"\s*:\s*")[^"]*(")`),
+}
+
+// Redact hides the secrets, such as passwords and S3 secret keys, found in a
+// request or response body
+func Redact(body string) string {
+	for _, pattern := range secretPatterns {
+		body = pattern.ReplaceAllString(body, "${1}***${2}")
+	}
+
+	return body
+}
+
+// LoggingInterceptor logs every call. Request and response bodies are logged
+// too when logBodies is set, with their secrets redacted.
+func LoggingInterceptor(logger *slog.Logger, logBodies bool) Interceptor {
+	return func(ctx context.Context, call *Call, next Handler) (*Result, error) {
+		// The body is consumed once sent
+		var requestBody []byte
+		if logBodies {
+			body, _ := call.PeekBody()
+			requestBody = bytes.Clone(body)
+		}
+
+		start := time.Now()
+		result, err := next(ctx, call)
+
+		attrs := []slog.Attr{
+			slog.String("api", call.API),
+			slog.String("method", call.Method),
+			slog.String("path", call.Path),
+			slog.String("resource", call.Resource),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if logBodies && len(requestBody) > 0 {
+			attrs = append(attrs, slog.String("request_body", Redact(string(requestBody))))
+		}
+
+		level := slog.LevelInfo
+		if err != nil {
+			level = slog.LevelError
+			attrs = append(attrs, slog.String("error", err.Error()))
+		} else {
+			attrs = append(attrs, slog.Int("status", result.StatusCode))
+			if result.StatusCode >= 400 {
+				level = slog.LevelWarn
+			}
+			if logBodies {
+				attrs = append(attrs, slog.String("response_body", Redact(result.Body)))
+			}
+		}
+
+		logger.LogAttrs(ctx, level, "geoserver call", attrs...)
+
+		return result, err
+	}
+}
+
+// MetricsRecorder receives the measures of every call, e.g. to feed
+// Prometheus collectors labelled by resource
+type MetricsRecorder interface {
+	ObserveCall(api, resource, method string, statusCode int, duration time.Duration, err error)
+}
+
+// MetricsInterceptor reports every call to the recorder. The status code is
+// 0 when no response was received.
+func MetricsInterceptor(recorder MetricsRecorder) Interceptor {
+	return func(ctx context.Context, call *Call, next Handler) (*Result, error) {
+		start := time.Now()
+		result, err := next(ctx, call)
+
+		statusCode := 0
+		if result != nil {
+			statusCode = result.StatusCode
+		}
+		recorder.ObserveCall(call.API, call.Resource, call.Method, statusCode, time.Since(start), err)
+
+		return result, err
+	}
+}
+
+// Tracer starts a span for every call, e.g. with OpenTelemetry
+type Tracer interface {
+	// Start starts a span. The returned context is used to send the call.
+	Start(ctx context.Context, call *Call) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer
+type Span interface {
+	// End ends the span, result is nil when err is set
+	End(result *Result, err error)
+}
+
+// TracingInterceptor wraps every call into a span
+func TracingInterceptor(tracer Tracer) Interceptor {
+	return func(ctx context.Context, call *Call, next Handler) (*Result, error) {
+		ctx, span := tracer.Start(ctx, call)
+		result, err := next(ctx, call)
+		span.End(result, err)
+
+		return result, err
+	}
+}
@@ -0,0 +1,199 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type observedCall struct {
+	api, resource, method string
+	statusCode            int
+}
+
+type testRecorder struct {
+	calls []observedCall
+}
+
+func (r *testRecorder) ObserveCall(api, resource, method string, statusCode int, duration time.Duration, err error) {
+	r.calls = append(r.calls, observedCall{api, resource, method, statusCode})
+}
+
+type testSpan struct {
+	tracer *testTracer
+}
+
+func (s *testSpan) End(result *Result, err error) {
+	s.tracer.ended = append(s.tracer.ended, result.StatusCode)
+}
+
+type testTracerKey struct{}
+
+type testTracer struct {
+	started []string
+	ended   []int
+}
+
+func (t *testTracer) Start(ctx context.Context, call *Call) (context.Context, Span) {
+	t.started = append(t.started, call.Method+" "+call.Resource)
+	return context.WithValue(ctx, testTracerKey{}, "span"), &testSpan{tracer: t}
+}
+
+func TestResourceOf(t *testing.T) {
+	tests := map[string]string{
+		"/workspaces?default=true":                                                  "workspaces",
+		"/workspaces/topp":                                                          "workspaces",
+		"/workspaces/topp/datastores/ds/featuretypes/f":                             "workspaces/datastores/featuretypes",
+		"/layers/topp:roads?recurse=true":                                           "layers",
+		"/security/acl/layers/*.*.r":                                                "security/acl/layers",
+		"/security/acl/services/wfs.Transaction":                                    "security/acl/services",
+		"/security/authproviders/ldap":                                              "security/authproviders",
+		"/security/authfilters/basic":                                               "security/authfilters",
+		"/security/filterChain/web":                                                 "security/filterChain",
+		"/security/usergroupservices/default":                                       "security/usergroupservices",
+		"/security/roleservices/ldap":                                               "security/roleservices",
+		"/security/passwordpolicies/strong":                                         "security/passwordpolicies",
+		"/services/wms/workspaces/topp/settings":                                    "services/wms/workspaces/settings",
+		"/security/usergroup/service/default/user/alice":                            "security/usergroup/service/user",
+		"/resource/styles/foo.sld":                                                  "resource",
+		"/workspaces/topp/coveragestores/cs/coverages/index/granules/c.123.json":    "workspaces/coveragestores/coverages/index/granules",
+		"/workspaces/topp/coveragestores/cs/coverages/index/granules.json?limit=10": "workspaces/coveragestores/coverages/index/granules.json",
+		"/workspaces/topp/datastores/roads/file.shp?update=overwrite":               "workspaces/datastores/file",
+		"/workspaces/topp/unknownthings/secret/items/name":                          "workspaces",
+		"/security/roles/service/ldap/role/ROLE_PARTNER/user/wile":                  "security/roles/service/role/user",
+		"/security/authproviders/order":                                             "security/authproviders/order",
+		"/diskquota.xml":                                                            "diskquota.xml",
+	}
+
+	for path, expected := range tests {
+		assert.Equal(t, expected, resourceOf(path), path)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	body := `<dataStore><connectionParameters>` +
+		`<entry key="user">geo</entry><entry key="passwd">s3cr3t</entry>` +
+		`</connectionParameters></dataStore>` +
+		`<S3BlobStore><awsAccessKey>AKIA</awsAccessKey><awsSecretKey>hidden</awsSecretKey></S3BlobStore>` +
+		`<user><userName>alice</userName><password>pa55</password></user>`
+
+	redacted := Redact(body)
+
+	assert.NotContains(t, redacted, "s3cr3t")
+	assert.NotContains(t, redacted, "hidden")
+	assert.NotContains(t, redacted, "pa55")
+	assert.Contains(t, redacted, `<entry key="user">geo</entry>`)
+	assert.Contains(t, redacted, `<entry key="passwd">***</entry>`)
+	assert.Contains(t, redacted, `<awsSecretKey>***</awsSecretKey>`)
+	assert.Contains(t, redacted, `<awsAccessKey>AKIA</awsAccessKey>`)
+}
+
+func TestRedactPasswords(t *testing.T) {
+	bodies := []string{
+		// GetMasterPassword response
+		`<masterPassword><oldMasterPassword>geoserver</oldMasterPassword></masterPassword>`,
+		// UpdateMasterPassword request
+		`<masterPassword><oldMasterPassword>geoserver</oldMasterPassword><newMasterPassword>s3cr3t</newMasterPassword></masterPassword>`,
+		// ChangePassword request
+		`<userPassword><newPassword>s3cr3t</newPassword></userPassword>`,
+	}
+
+	for _, body := range bodies {
+		redacted := Redact(body)
+
+		assert.NotContains(t, redacted, "geoserver", body)
+		assert.NotContains(t, redacted, "s3cr3t", body)
+	}
+	assert.Equal(t, `<userPassword><newPassword>***</newPassword></userPassword>`, Redact(bodies[2]))
+}
+
+func TestInterceptorsOrder(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	var order []string
+	trace := func(name string) Interceptor {
+		return func(ctx context.Context, call *Call, next Handler) (*Result, error) {
+			order = append(order, "before "+name)
+			result, err := next(ctx, call)
+			order = append(order, "after "+name)
+			return result, err
+		}
+	}
+
+	cli, err := NewClient(testServer.URL, "", "", WithInterceptors(trace("outer"), trace("inner")))
+	assert.Nil(t, err)
+
+	err = cli.DeleteWorkspace("topp", false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"before outer", "before inner", "after inner", "after outer"}, order)
+}
+
+func TestLoggingInterceptor(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	var output bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&output, nil))
+
+	cli, err := NewClient(testServer.URL, "", "", WithInterceptors(LoggingInterceptor(logger, true)))
+	assert.Nil(t, err)
+
+	err = cli.CreateDatastore("topp", &Datastore{
+		Name: "postgis",
+		ConnectionParameters: []*DatastoreConnectionParameter{
+			{Key: "passwd", Value: "s3cr3t"},
+		},
+	})
+	assert.Nil(t, err)
+
+	var entry map[string]any
+	assert.Nil(t, json.Unmarshal(output.Bytes(), &entry))
+	assert.Equal(t, "POST", entry["method"])
+	assert.Equal(t, "/workspaces/topp/datastores", entry["path"])
+	assert.Equal(t, "workspaces/datastores", entry["resource"])
+	assert.Equal(t, float64(201), entry["status"])
+	assert.Contains(t, entry["request_body"], `<entry key="passwd">***</entry>`)
+	assert.False(t, strings.Contains(output.String(), "s3cr3t"))
+}
+
+func TestMetricsAndTracingInterceptors(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+	}))
+	defer testServer.Close()
+
+	recorder := &testRecorder{}
+	tracer := &testTracer{}
+	var spanInContext any
+	cli, err := NewClientFromRoot(testServer.URL, "", "", WithInterceptors(
+		MetricsInterceptor(recorder),
+		TracingInterceptor(tracer),
+		func(ctx context.Context, call *Call, next Handler) (*Result, error) {
+			spanInContext = ctx.Value(testTracerKey{})
+			return next(ctx, call)
+		},
+	))
+	assert.Nil(t, err)
+
+	_, err = cli.GetGwcGsLayer("topp:roads")
+	assert.Error(t, err)
+
+	assert.Equal(t, []observedCall{{APIGwc, "layers", "GET", 404}}, recorder.calls)
+	assert.Equal(t, []string{"GET layers"}, tracer.started)
+	assert.Equal(t, []int{404}, tracer.ended)
+	assert.Equal(t, "span", spanInContext)
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -61,6 +62,28 @@ type Layers struct {
 
 // GetLayers returns all the layers
 func (c *Client) GetLayers(workspace string) (layers []*Layer, err error) {
+	return c.GetLayersContext(context.Background(), workspace)
+}
+
+// GetLayersContext is like GetLayers but carries ctx down to the HTTP requests
+func (c *Client) GetLayersContext(ctx context.Context, workspace string) (layers []*Layer, err error) {
+	names, err := c.GetLayerNamesContext(ctx, workspace)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*Layer, error) {
+		return c.GetLayerContext(ctx, workspace, name)
+	})
+}
+
+// GetLayerNames returns the names of the layers, without fetching them
+func (c *Client) GetLayerNames(workspace string) (names []string, err error) {
+	return c.GetLayerNamesContext(context.Background(), workspace)
+}
+
+// GetLayerNamesContext is like GetLayerNames but carries ctx down to the HTTP requests
+func (c *Client) GetLayerNamesContext(ctx context.Context, workspace string) (names []string, err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -69,35 +92,30 @@ func (c *Client) GetLayers(workspace string) (layers []*Layer, err error) {
 		endpoint = fmt.Sprintf("/workspaces/%s/layers", workspace)
 	}
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
 	var data Layers
 
 	if err := xml.Unmarshal([]byte(body), &data); err != nil {
-		return layers, err
+		return names, err
 	}
 
 	for _, layerRef := range data.List {
-		layer, err := c.GetLayer(workspace, layerRef.Name)
-		if err != nil {
-			return layers, err
-		}
-
-		layers = append(layers, layer)
+		names = append(names, layerRef.Name)
 	}
 
 	return
@@ -105,6 +123,11 @@ func (c *Client) GetLayers(workspace string) (layers []*Layer, err error) {
 
 // GetLayer return a single layer based on its name
 func (c *Client) GetLayer(workspace, name string) (layer *Layer, err error) {
+	return c.GetLayerContext(context.Background(), workspace, name)
+}
+
+// GetLayerContext is like GetLayer but carries ctx down to the HTTP requests
+func (c *Client) GetLayerContext(ctx context.Context, workspace, name string) (layer *Layer, err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -113,22 +136,22 @@ func (c *Client) GetLayer(workspace, name string) (layer *Layer, err error) {
 		endpoint = fmt.Sprintf("/workspaces/%s/layers/%s", workspace, name)
 	}
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -144,6 +167,11 @@ func (c *Client) GetLayer(workspace, name string) (layer *Layer, err error) {
 
 // UpdateLayer updates a layer
 func (c *Client) UpdateLayer(workspace, layerName string, layer *Layer) (err error) {
+	return c.UpdateLayerContext(context.Background(), workspace, layerName, layer)
+}
+
+// UpdateLayerContext is like UpdateLayer but carries ctx down to the HTTP requests
+func (c *Client) UpdateLayerContext(ctx context.Context, workspace, layerName string, layer *Layer) (err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -157,31 +185,36 @@ func (c *Client) UpdateLayer(workspace, layerName string, layer *Layer) (err err
 	}
 	payload, _ := xml.Marshal(layer)
 
-	statusCode, body, err := c.doRequest("PUT", endpoint, bytes.NewBuffer(payload))
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteLayer deletes a layer
 func (c *Client) DeleteLayer(workspace, layerName string, recurse bool) (err error) {
+	return c.DeleteLayerContext(context.Background(), workspace, layerName, recurse)
+}
+
+// DeleteLayerContext is like DeleteLayer but carries ctx down to the HTTP requests
+func (c *Client) DeleteLayerContext(ctx context.Context, workspace, layerName string, recurse bool) (err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -189,28 +222,28 @@ func (c *Client) DeleteLayer(workspace, layerName string, recurse bool) (err err
 	} else {
 		endpoint = fmt.Sprintf("/workspaces/%s/layers/%s?recurse=%t", workspace, layerName, recurse)
 	}
-	statusCode, body, err := c.doRequest("DELETE", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
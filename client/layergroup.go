@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -52,6 +53,28 @@ type MetadataLink struct {
 
 // GetGroups returns all the groups
 func (c *Client) GetGroups(workspace string) (layerGroups []*LayerGroup, err error) {
+	return c.GetGroupsContext(context.Background(), workspace)
+}
+
+// GetGroupsContext is like GetGroups but carries ctx down to the HTTP requests
+func (c *Client) GetGroupsContext(ctx context.Context, workspace string) (layerGroups []*LayerGroup, err error) {
+	names, err := c.GetGroupNamesContext(ctx, workspace)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*LayerGroup, error) {
+		return c.GetGroupContext(ctx, workspace, name)
+	})
+}
+
+// GetGroupNames returns the names of the layer groups, without fetching them
+func (c *Client) GetGroupNames(workspace string) (names []string, err error) {
+	return c.GetGroupNamesContext(context.Background(), workspace)
+}
+
+// GetGroupNamesContext is like GetGroupNames but carries ctx down to the HTTP requests
+func (c *Client) GetGroupNamesContext(ctx context.Context, workspace string) (names []string, err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -60,35 +83,30 @@ func (c *Client) GetGroups(workspace string) (layerGroups []*LayerGroup, err err
 		endpoint = fmt.Sprintf("/workspaces/%s/layergroups", workspace)
 	}
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
 	var data LayerGroups
 
 	if err := xml.Unmarshal([]byte(body), &data); err != nil {
-		return layerGroups, err
+		return names, err
 	}
 
 	for _, groupRef := range data.List {
-		group, err := c.GetGroup(workspace, groupRef.Name)
-		if err != nil {
-			return layerGroups, err
-		}
-
-		layerGroups = append(layerGroups, group)
+		names = append(names, groupRef.Name)
 	}
 
 	return
@@ -96,6 +114,11 @@ func (c *Client) GetGroups(workspace string) (layerGroups []*LayerGroup, err err
 
 // GetGroup return a single group based on its name
 func (c *Client) GetGroup(workspace, name string) (layerGroup *LayerGroup, err error) {
+	return c.GetGroupContext(context.Background(), workspace, name)
+}
+
+// GetGroupContext is like GetGroup but carries ctx down to the HTTP requests
+func (c *Client) GetGroupContext(ctx context.Context, workspace, name string) (layerGroup *LayerGroup, err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -104,22 +127,22 @@ func (c *Client) GetGroup(workspace, name string) (layerGroup *LayerGroup, err e
 		endpoint = fmt.Sprintf("/workspaces/%s/layergroups/%s", workspace, name)
 	}
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -135,6 +158,11 @@ func (c *Client) GetGroup(workspace, name string) (layerGroup *LayerGroup, err e
 
 // CreateGroup creates a layer group
 func (c *Client) CreateGroup(workspace string, layerGroup *LayerGroup) (err error) {
+	return c.CreateGroupContext(context.Background(), workspace, layerGroup)
+}
+
+// CreateGroupContext is like CreateGroup but carries ctx down to the HTTP requests
+func (c *Client) CreateGroupContext(ctx context.Context, workspace string, layerGroup *LayerGroup) (err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -150,7 +178,7 @@ func (c *Client) CreateGroup(workspace string, layerGroup *LayerGroup) (err erro
 	if err != nil {
 		return
 	}
-	statusCode, body, err := c.doFullyTypedRequest("POST", endpoint, bytes.NewBuffer(payload), "application/xml", "")
+	statusCode, body, err := c.doFullyTypedRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload), "application/xml", "")
 
 	if err != nil {
 		return
@@ -158,18 +186,23 @@ func (c *Client) CreateGroup(workspace string, layerGroup *LayerGroup) (err erro
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s - %s", statusCode, body, string(payload))
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateGroup updates an existing layer group
 func (c *Client) UpdateGroup(workspace string, layerGroup *LayerGroup) (err error) {
+	return c.UpdateGroupContext(context.Background(), workspace, layerGroup)
+}
+
+// UpdateGroupContext is like UpdateGroup but carries ctx down to the HTTP requests
+func (c *Client) UpdateGroupContext(ctx context.Context, workspace string, layerGroup *LayerGroup) (err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -183,7 +216,7 @@ func (c *Client) UpdateGroup(workspace string, layerGroup *LayerGroup) (err erro
 	}
 	payload, _ := xml.Marshal(layerGroup)
 
-	statusCode, body, err := c.doRequest("PUT", endpoint, bytes.NewBuffer(payload))
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 
 	if err != nil {
 		return
@@ -191,24 +224,29 @@ func (c *Client) UpdateGroup(workspace string, layerGroup *LayerGroup) (err erro
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteGroup deletes layer group from GeoServer
 func (c *Client) DeleteGroup(workspace string, layerGroup string) (err error) {
+	return c.DeleteGroupContext(context.Background(), workspace, layerGroup)
+}
+
+// DeleteGroupContext is like DeleteGroup but carries ctx down to the HTTP requests
+func (c *Client) DeleteGroupContext(ctx context.Context, workspace string, layerGroup string) (err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -217,28 +255,28 @@ func (c *Client) DeleteGroup(workspace string, layerGroup string) (err error) {
 		endpoint = fmt.Sprintf("/workspaces/%s/layergroups/%s", workspace, layerGroup)
 	}
 
-	statusCode, body, err := c.doRequest("DELETE", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
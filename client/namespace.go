@@ -0,0 +1,256 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+)
+
+// NamespaceReference is a reference to a Namespace
+type NamespaceReference struct {
+	Name string `xml:"name"`
+}
+
+// Namespaces is a list of NamespaceReference
+type Namespaces struct {
+	XMLName xml.Name              `xml:"namespaces"`
+	List    []*NamespaceReference `xml:"namespace"`
+}
+
+// Namespace is the XML namespace of a workspace, identified by its prefix
+// which is the name of the workspace
+type Namespace struct {
+	XMLName  xml.Name `xml:"namespace"`
+	Prefix   string   `xml:"prefix"`
+	URI      string   `xml:"uri"`
+	Isolated bool     `xml:"isolated"`
+}
+
+// GetNamespaces returns the list of the namespaces
+func (c *Client) GetNamespaces() (namespaces []*Namespace, err error) {
+	return c.GetNamespacesContext(context.Background())
+}
+
+// GetNamespacesContext is like GetNamespaces but carries ctx down to the HTTP requests
+func (c *Client) GetNamespacesContext(ctx context.Context) (namespaces []*Namespace, err error) {
+	names, err := c.GetNamespaceNamesContext(ctx)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, c.GetNamespaceContext)
+}
+
+// GetNamespaceNames returns the prefixes of the namespaces, without fetching them
+func (c *Client) GetNamespaceNames() (names []string, err error) {
+	return c.GetNamespaceNamesContext(context.Background())
+}
+
+// GetNamespaceNamesContext is like GetNamespaceNames but carries ctx down to the HTTP requests
+func (c *Client) GetNamespaceNamesContext(ctx context.Context) (names []string, err error) {
+	endpoint := "/namespaces"
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data Namespaces
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return names, nil
+	}
+
+	for _, namespaceRef := range data.List {
+		names = append(names, namespaceRef.Name)
+	}
+
+	return
+}
+
+// GetNamespace return a single namespace based on its prefix
+func (c *Client) GetNamespace(prefix string) (namespace *Namespace, err error) {
+	return c.GetNamespaceContext(context.Background(), prefix)
+}
+
+// GetNamespaceContext is like GetNamespace but carries ctx down to the HTTP requests
+func (c *Client) GetNamespaceContext(ctx context.Context, prefix string) (namespace *Namespace, err error) {
+	endpoint := fmt.Sprintf("/namespaces/%s", prefix)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data Namespace
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return namespace, err
+	}
+
+	namespace = &data
+
+	return
+}
+
+// CreateNamespace creates a namespace, along with the workspace of the same
+// name
+func (c *Client) CreateNamespace(namespace *Namespace) (err error) {
+	return c.CreateNamespaceContext(context.Background(), namespace)
+}
+
+// CreateNamespaceContext is like CreateNamespace but carries ctx down to the HTTP requests
+func (c *Client) CreateNamespaceContext(ctx context.Context, namespace *Namespace) (err error) {
+	namespace.XMLName = xml.Name{
+		Local: "namespace",
+	}
+	payload, err := xml.Marshal(namespace)
+	if err != nil {
+		return
+	}
+
+	endpoint := "/namespaces"
+	statusCode, body, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 409:
+		err = newAPIError("POST", endpoint, statusCode, body, ErrConflict)
+		return
+	case 201:
+		return
+	default:
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// UpdateNamespace updates a namespace
+func (c *Client) UpdateNamespace(prefix string, namespace *Namespace) (err error) {
+	return c.UpdateNamespaceContext(context.Background(), prefix, namespace)
+}
+
+// UpdateNamespaceContext is like UpdateNamespace but carries ctx down to the HTTP requests
+func (c *Client) UpdateNamespaceContext(ctx context.Context, prefix string, namespace *Namespace) (err error) {
+	namespace.XMLName = xml.Name{
+		Local: "namespace",
+	}
+	payload, err := xml.Marshal(namespace)
+	if err != nil {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/namespaces/%s", prefix)
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// DeleteNamespace deletes a namespace, along with the empty workspace of the
+// same name
+func (c *Client) DeleteNamespace(prefix string) (err error) {
+	return c.DeleteNamespaceContext(context.Background(), prefix)
+}
+
+// DeleteNamespaceContext is like DeleteNamespace but carries ctx down to the HTTP requests
+func (c *Client) DeleteNamespaceContext(ctx context.Context, prefix string) (err error) {
+	endpoint := fmt.Sprintf("/namespaces/%s", prefix)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 403:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
+		return
+	case 404:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// CreateWorkspaceWithNamespace creates a workspace and sets the URI of its
+// namespace. GeoServer creating the namespace with a default URI along with
+// the workspace, the workspace is deleted again if the URI cannot be set, so
+// that no workspace is left with the wrong namespace.
+func (c *Client) CreateWorkspaceWithNamespace(name, uri string, isolated, isDefault bool) (err error) {
+	return c.CreateWorkspaceWithNamespaceContext(context.Background(), name, uri, isolated, isDefault)
+}
+
+// CreateWorkspaceWithNamespaceContext is like CreateWorkspaceWithNamespace but carries ctx down to the HTTP requests
+func (c *Client) CreateWorkspaceWithNamespaceContext(ctx context.Context, name, uri string, isolated, isDefault bool) (err error) {
+	err = c.CreateWorkspaceContext(ctx, &Workspace{Name: name, Isolated: isolated}, isDefault)
+	if err != nil {
+		return
+	}
+
+	err = c.UpdateNamespaceContext(ctx, name, &Namespace{Prefix: name, URI: uri, Isolated: isolated})
+	if err == nil {
+		return
+	}
+
+	// Roll back even when ctx is the reason of the failure
+	if rollbackErr := c.DeleteWorkspaceContext(context.WithoutCancel(ctx), name, false); rollbackErr != nil {
+		err = errors.Join(err, fmt.Errorf("rolling back workspace %s: %w", name, rollbackErr))
+	}
+
+	return
+}
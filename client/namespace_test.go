@@ -0,0 +1,213 @@
+package client
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNamespacesSuccess(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/namespaces", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<namespaces>
+			<namespace>
+				<name>topp</name>
+				<atom:link xmlns:atom="http://www.w3.org/2005/Atom" rel="alternate" href="http://localhost:8080/geoserver/rest/namespaces/topp.xml" type="application/xml"/>
+			</namespace>
+		</namespaces>
+		`))
+	})
+	mux.HandleFunc("/namespaces/topp", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<namespace>
+			<prefix>topp</prefix>
+			<uri>http://www.openplans.org/topp</uri>
+			<isolated>false</isolated>
+		</namespace>
+		`))
+	})
+
+	testServer := httptest.NewServer(mux)
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	namespaces, err := cli.GetNamespaces()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*Namespace{
+		{
+			XMLName: xml.Name{
+				Local: "namespace",
+			},
+			Prefix: "topp",
+			URI:    "http://www.openplans.org/topp",
+		},
+	}, namespaces)
+}
+
+func TestCreateNamespaceSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/namespaces")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<namespace><prefix>sf</prefix><uri>http://www.openplans.org/spearfish</uri><isolated>true</isolated></namespace>`, string(rawBody))
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateNamespace(&Namespace{
+		Prefix:   "sf",
+		URI:      "http://www.openplans.org/spearfish",
+		Isolated: true,
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestUpdateNamespaceNotFound(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/namespaces/sf")
+
+		w.WriteHeader(404)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateNamespace("sf", &Namespace{Prefix: "sf", URI: "http://sf"})
+
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestDeleteNamespaceSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.Path, "/namespaces/sf")
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteNamespace("sf")
+
+	assert.Nil(t, err)
+}
+
+func TestCreateWorkspaceWithNamespaceSuccess(t *testing.T) {
+	var calls []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+
+		switch r.Method {
+		case "POST":
+			assert.Equal(t, `<workspace><name>sf</name><isolated>true</isolated></workspace>`, string(rawBody))
+			w.WriteHeader(201)
+		case "PUT":
+			assert.Equal(t, `<namespace><prefix>sf</prefix><uri>http://partner.org/sf</uri><isolated>true</isolated></namespace>`, string(rawBody))
+			w.WriteHeader(200)
+		}
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateWorkspaceWithNamespace("sf", "http://partner.org/sf", true, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"POST /workspaces", "PUT /namespaces/sf"}, calls)
+}
+
+func TestCreateWorkspaceWithNamespaceRollback(t *testing.T) {
+	var calls []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(201)
+		case "PUT":
+			w.WriteHeader(500)
+			w.Write([]byte(`Invalid URI`))
+		case "DELETE":
+			assert.Equal(t, r.URL.Query().Get("recurse"), "false")
+			w.WriteHeader(200)
+		}
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateWorkspaceWithNamespace("sf", "not a uri", false, false)
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 500, apiErr.StatusCode)
+	assert.Equal(t, []string{"POST /workspaces", "PUT /namespaces/sf", "DELETE /workspaces/sf"}, calls)
+}
+
+func TestCreateWorkspaceWithNamespaceRollbackFailure(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(201)
+		case "PUT":
+			w.WriteHeader(500)
+		case "DELETE":
+			w.WriteHeader(401)
+		}
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateWorkspaceWithNamespace("sf", "http://sf", false, false)
+
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+	assert.Contains(t, err.Error(), "rolling back workspace sf")
+}
@@ -0,0 +1,217 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// Option configures a Client built by NewClient
+type Option func(*Client) error
+
+// WithTimeout sets the time limit of each attempt of a request. A retried
+// request can therefore last as many times longer as it is attempted, plus
+// the delays between the attempts.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		c.httpClient().Timeout = timeout
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with each request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithHTTPClient replaces the HTTP client used to send requests
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return fmt.Errorf("HTTP client must not be nil")
+		}
+
+		c.HTTPClient = httpClient
+		return nil
+	}
+}
+
+// WithTransport replaces the RoundTripper used to send requests. TLS and proxy
+// options given before it are discarded.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) error {
+		c.httpClient().Transport = transport
+		return nil
+	}
+}
+
+// WithAuthenticator sets the authenticator adding credentials to the requests
+func WithAuthenticator(authenticator Authenticator) Option {
+	return func(c *Client) error {
+		c.Authenticator = authenticator
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the policy deciding whether failed requests are retried
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) error {
+		c.RetryPolicy = policy
+		return nil
+	}
+}
+
+// WithMaxConcurrency sets the number of items fetched at the same time when
+// listing resources
+func WithMaxConcurrency(maxConcurrency int) Option {
+	return func(c *Client) error {
+		c.MaxConcurrency = maxConcurrency
+		return nil
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used to connect to GeoServer
+func WithTLSConfig(config *tls.Config) Option {
+	return func(c *Client) error {
+		transport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+
+		transport.TLSClientConfig = config
+		return nil
+	}
+}
+
+// WithCACertificates trusts the PEM encoded certificates in addition to the
+// system ones
+func WithCACertificates(pemCerts []byte) Option {
+	return func(c *Client) error {
+		config, err := c.tlsConfig()
+		if err != nil {
+			return err
+		}
+
+		if config.RootCAs == nil {
+			if config.RootCAs, err = x509.SystemCertPool(); err != nil {
+				config.RootCAs = x509.NewCertPool()
+			}
+		} else {
+			config.RootCAs = config.RootCAs.Clone()
+		}
+		if !config.RootCAs.AppendCertsFromPEM(pemCerts) {
+			return fmt.Errorf("no valid certificate found in CA bundle")
+		}
+		return nil
+	}
+}
+
+// WithCAFile trusts the certificates of a PEM encoded CA bundle in addition to
+// the system ones
+func WithCAFile(path string) Option {
+	return func(c *Client) error {
+		pemCerts, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		return WithCACertificates(pemCerts)(c)
+	}
+}
+
+// WithClientCertificate authenticates the client with a certificate (mutual
+// TLS), given as PEM encoded files
+func WithClientCertificate(certFile, keyFile string) Option {
+	return func(c *Client) error {
+		certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return err
+		}
+
+		config, err := c.tlsConfig()
+		if err != nil {
+			return err
+		}
+
+		config.Certificates = append(config.Certificates, certificate)
+		return nil
+	}
+}
+
+// WithProxy sends requests through the given proxy. An empty URL disables
+// proxies, including the ones set in the environment.
+func WithProxy(proxyURL string) Option {
+	return func(c *Client) error {
+		transport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+
+		if proxyURL == "" {
+			transport.Proxy = nil
+			return nil
+		}
+
+		parsed, err := url.Parse(proxyURL)
+		if err != nil {
+			return err
+		}
+		transport.Proxy = http.ProxyURL(parsed)
+		return nil
+	}
+}
+
+// httpClient copies the HTTP client of the client before it is configured,
+// so that a client shared with other code, such as http.DefaultClient, is
+// left untouched
+func (c *Client) httpClient() *http.Client {
+	httpClient := *c.HTTPClient
+	c.HTTPClient = &httpClient
+	return c.HTTPClient
+}
+
+// httpTransport returns a copy of the *http.Transport of the client, created
+// from the default one if needed, for the same reason
+func (c *Client) httpTransport() (*http.Transport, error) {
+	httpClient := c.httpClient()
+	switch transport := httpClient.Transport.(type) {
+	case nil:
+		defaultTransport := http.DefaultTransport.(*http.Transport).Clone()
+		httpClient.Transport = defaultTransport
+		return defaultTransport, nil
+	case *http.Transport:
+		cloned := transport.Clone()
+		httpClient.Transport = cloned
+		return cloned, nil
+	default:
+		return nil, fmt.Errorf("cannot configure transport of type %T", transport)
+	}
+}
+
+// tlsConfig returns the TLS configuration of the client, creating it if needed
+func (c *Client) tlsConfig() (*tls.Config, error) {
+	transport, err := c.httpTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	return transport.TLSClientConfig, nil
+}
+
+// WithInterceptors appends interceptors wrapping every call
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(c *Client) error {
+		c.Interceptors = append(c.Interceptors, interceptors...)
+		return nil
+	}
+}
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func TestNewClientDefaults(t *testing.T) {
+	cli, err := NewClient("http://localhost:8080/geoserver/rest", "admin", "geoserver")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "http://localhost:8080/geoserver/rest", cli.URL)
+	assert.Equal(t, "admin", cli.Username)
+	assert.Equal(t, "geoserver", cli.Password)
+	assert.NotNil(t, cli.HTTPClient)
+	assert.Nil(t, cli.HTTPClient.Transport)
+}
+
+func TestNewClientUserAgentAndTimeout(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "provisioner/1.0", r.Header.Get("User-Agent"))
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<workspaces></workspaces>`))
+	}))
+	defer testServer.Close()
+
+	cli, err := NewClient(testServer.URL, "", "",
+		WithUserAgent("provisioner/1.0"),
+		WithTimeout(5*time.Second),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, 5*time.Second, cli.HTTPClient.Timeout)
+
+	_, err = cli.GetWorkspaces()
+
+	assert.Nil(t, err)
+}
+
+func TestNewClientWithTransport(t *testing.T) {
+	called := false
+	transport := roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		called = true
+		return httptest.NewRecorder().Result(), nil
+	})
+
+	cli, err := NewClient("http://geoserver/rest", "", "", WithTransport(transport))
+	assert.Nil(t, err)
+
+	err = cli.DeleteWorkspace("topp", false)
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestNewClientLeavesHTTPClientUntouched(t *testing.T) {
+	transport := &http.Transport{}
+	shared := &http.Client{Transport: transport}
+
+	cli, err := NewClient("https://geoserver/rest", "", "",
+		WithHTTPClient(shared),
+		WithTimeout(5*time.Second),
+		WithProxy(""),
+		WithTLSConfig(&tls.Config{ServerName: "geoserver"}),
+	)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 5*time.Second, cli.HTTPClient.Timeout)
+	assert.Equal(t, "geoserver", cli.HTTPClient.Transport.(*http.Transport).TLSClientConfig.ServerName)
+	assert.Equal(t, time.Duration(0), shared.Timeout)
+	assert.Same(t, transport, shared.Transport)
+	// Cloning a transport may set up its HTTP/2 defaults, but nothing more
+	assert.True(t, transport.TLSClientConfig == nil || transport.TLSClientConfig.ServerName == "")
+}
+
+func TestNewClientWithNilHTTPClient(t *testing.T) {
+	_, err := NewClient("https://geoserver/rest", "", "", WithHTTPClient(nil), WithProxy(""))
+
+	assert.Error(t, err)
+}
+
+func TestNewClientTLSOptionWithCustomTransport(t *testing.T) {
+	transport := roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+
+	_, err := NewClient("https://geoserver/rest", "", "", WithTransport(transport), WithProxy(""))
+
+	assert.Error(t, err)
+}
+
+func TestNewClientWithCACertificates(t *testing.T) {
+	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		w.Write([]byte(`<workspaces></workspaces>`))
+	}))
+	defer testServer.Close()
+
+	pemCerts := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: testServer.Certificate().Raw,
+	})
+
+	cli, err := NewClient(testServer.URL, "", "", WithCACertificates(pemCerts))
+	assert.Nil(t, err)
+
+	_, err = cli.GetWorkspaces()
+	assert.Nil(t, err)
+
+	untrusted, err := NewClient(testServer.URL, "", "")
+	assert.Nil(t, err)
+
+	_, err = untrusted.GetWorkspaces()
+	assert.Error(t, err)
+}
+
+func TestNewClientWithInvalidCAFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	assert.Nil(t, os.WriteFile(path, []byte("not a certificate"), 0600))
+
+	_, err := NewClient("https://geoserver/rest", "", "", WithCAFile(path))
+
+	assert.Error(t, err)
+}
+
+func TestNewClientWithClientCertificate(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "provisioner"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	assert.Nil(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.Nil(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	cli, err := NewClient("https://geoserver/rest", "", "", WithClientCertificate(certFile, keyFile))
+
+	assert.Nil(t, err)
+	transport := cli.HTTPClient.Transport.(*http.Transport)
+	assert.Len(t, transport.TLSClientConfig.Certificates, 1)
+}
+
+func TestNewClientWithProxy(t *testing.T) {
+	cli, err := NewClient("http://geoserver/rest", "", "", WithProxy("http://proxy:3128"))
+	assert.Nil(t, err)
+
+	transport := cli.HTTPClient.Transport.(*http.Transport)
+	request := httptest.NewRequest("GET", "http://geoserver/rest/workspaces", nil)
+	proxyURL, err := transport.Proxy(request)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "http://proxy:3128", proxyURL.String())
+}
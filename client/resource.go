@@ -1,12 +1,18 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"strings"
 )
 
 // GetResource returns a file stored in the resource store
 func (c *Client) GetResource(pathToResource string, resourceExtension string) (resourceContent string, err error) {
+	return c.GetResourceContext(context.Background(), pathToResource, resourceExtension)
+}
+
+// GetResourceContext is like GetResource but carries ctx down to the HTTP requests
+func (c *Client) GetResourceContext(ctx context.Context, pathToResource string, resourceExtension string) (resourceContent string, err error) {
 	if resourceExtension == "" {
 		err = fmt.Errorf("retrieving content of resource is only possible for files")
 		return
@@ -14,19 +20,19 @@ func (c *Client) GetResource(pathToResource string, resourceExtension string) (r
 
 	var endpoint string = fmt.Sprintf("/resource/%s.%s", pathToResource, resourceExtension)
 
-	statusCode, resourceContent, err := c.doRequest("GET", endpoint, nil)
+	statusCode, resourceContent, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 404:
-		err = fmt.Errorf("resource not found")
+		err = newAPIError("GET", endpoint, statusCode, resourceContent, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, resourceContent)
+		err = newAPIError("GET", endpoint, statusCode, resourceContent, nil)
 		return
 	}
 
@@ -35,6 +41,11 @@ func (c *Client) GetResource(pathToResource string, resourceExtension string) (r
 
 // CreateResource creates a resource on GeoServer
 func (c *Client) CreateResource(pathToResource string, resourceExtension string, resourceContent string) (err error) {
+	return c.CreateResourceContext(context.Background(), pathToResource, resourceExtension, resourceContent)
+}
+
+// CreateResourceContext is like CreateResource but carries ctx down to the HTTP requests
+func (c *Client) CreateResourceContext(ctx context.Context, pathToResource string, resourceExtension string, resourceContent string) (err error) {
 	if resourceExtension == "" {
 		err = fmt.Errorf("creation of resource is only possible for files")
 		return
@@ -46,7 +57,7 @@ func (c *Client) CreateResource(pathToResource string, resourceExtension string,
 	}
 
 	var endpoint string = fmt.Sprintf("/resource/%s.%s", pathToResource, resourceExtension)
-	statusCode, body, err := c.doRequest("PUT", endpoint, strings.NewReader(resourceContent))
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, strings.NewReader(resourceContent))
 
 	if err != nil {
 		return
@@ -54,23 +65,28 @@ func (c *Client) CreateResource(pathToResource string, resourceExtension string,
 
 	switch statusCode {
 	case 404:
-		err = fmt.Errorf("source path that doesn’t exist")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("PUT to directory or copy where source path is directory")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateResource updates an existing resource
 func (c *Client) UpdateResource(pathToResource string, resourceExtension string, resourceContent string) (err error) {
+	return c.UpdateResourceContext(context.Background(), pathToResource, resourceExtension, resourceContent)
+}
+
+// UpdateResourceContext is like UpdateResource but carries ctx down to the HTTP requests
+func (c *Client) UpdateResourceContext(ctx context.Context, pathToResource string, resourceExtension string, resourceContent string) (err error) {
 	if resourceExtension == "" {
 		err = fmt.Errorf("creation of resource is only possible for files")
 		return
@@ -82,7 +98,7 @@ func (c *Client) UpdateResource(pathToResource string, resourceExtension string,
 	}
 
 	var endpoint string = fmt.Sprintf("/resource/%s.%s", pathToResource, resourceExtension)
-	statusCode, body, err := c.doRequest("PUT", endpoint, strings.NewReader(resourceContent))
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, strings.NewReader(resourceContent))
 
 	if err != nil {
 		return
@@ -90,47 +106,52 @@ func (c *Client) UpdateResource(pathToResource string, resourceExtension string,
 
 	switch statusCode {
 	case 404:
-		err = fmt.Errorf("source path that doesn’t exist")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("PUT to directory or copy where source path is directory")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteResource deletes a resource from GeoServer
 func (c *Client) DeleteResource(resource string) (err error) {
+	return c.DeleteResourceContext(context.Background(), resource)
+}
+
+// DeleteResourceContext is like DeleteResource but carries ctx down to the HTTP requests
+func (c *Client) DeleteResourceContext(ctx context.Context, resource string) (err error) {
 	var endpoint string = fmt.Sprintf("/resource/%s", resource)
 
-	statusCode, body, err := c.doRequest("DELETE", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
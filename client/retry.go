@@ -0,0 +1,109 @@
+package client
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy decides whether a request must be sent again to GeoServer
+type RetryPolicy interface {
+	// Retry is called after each attempt, attempt starting at 1. Either
+	// response or err is set. It returns whether the request must be sent
+	// again and how long to wait before doing so.
+	Retry(attempt int, request *http.Request, response *http.Response, err error) (delay time.Duration, retry bool)
+}
+
+// BackoffPolicy is a RetryPolicy using an exponential backoff with jitter
+type BackoffPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one
+	MaxAttempts int
+	// MinDelay is the delay before the first retry, doubled on each attempt
+	MinDelay time.Duration
+	// MaxDelay caps the computed delay, as well as the one asked by a
+	// Retry-After header
+	MaxDelay time.Duration
+	// Jitter is the fraction of the delay, between 0 and 1, that is randomized
+	Jitter float64
+	// RetryableStatusCodes are the status codes triggering a retry
+	RetryableStatusCodes []int
+	// RetryNonIdempotent allows to retry POST and PATCH requests
+	RetryNonIdempotent bool
+}
+
+// NewBackoffPolicy returns a BackoffPolicy retrying idempotent requests on
+// transient errors and unavailable GeoServer instances
+func NewBackoffPolicy() *BackoffPolicy {
+	return &BackoffPolicy{
+		MaxAttempts:          4,
+		MinDelay:             500 * time.Millisecond,
+		MaxDelay:             30 * time.Second,
+		Jitter:               0.2,
+		RetryableStatusCodes: []int{429, 502, 503, 504},
+	}
+}
+
+// Retry implements RetryPolicy
+func (p *BackoffPolicy) Retry(attempt int, request *http.Request, response *http.Response, err error) (delay time.Duration, retry bool) {
+	if attempt >= p.MaxAttempts {
+		return
+	}
+
+	if !p.RetryNonIdempotent && !isIdempotent(request.Method) {
+		return
+	}
+
+	if err != nil {
+		// A canceled or expired context must not be retried
+		if request.Context().Err() != nil {
+			return
+		}
+	} else if !slices.Contains(p.RetryableStatusCodes, response.StatusCode) {
+		return
+	}
+
+	delay = p.MinDelay << (attempt - 1)
+	if delay > p.MaxDelay || delay <= 0 {
+		delay = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		delay = delay - time.Duration(p.Jitter*rand.Float64()*float64(delay))
+	}
+
+	if response != nil {
+		if retryAfter, ok := parseRetryAfter(response.Header.Get("Retry-After")); ok {
+			delay = min(retryAfter, p.MaxDelay)
+		}
+	}
+
+	return delay, true
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "TRACE", "PUT", "DELETE":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses a Retry-After header, given either as a number of
+// seconds or as an HTTP date
+func parseRetryAfter(value string) (delay time.Duration, ok bool) {
+	if value == "" {
+		return
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return
+}
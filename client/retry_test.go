@@ -0,0 +1,239 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testBackoffPolicy() *BackoffPolicy {
+	policy := NewBackoffPolicy()
+	policy.MinDelay = time.Millisecond
+	policy.MaxDelay = 5 * time.Millisecond
+	return policy
+}
+
+func TestRetryGetOnUnavailable(t *testing.T) {
+	attempts := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(503)
+			return
+		}
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<workspace><name>topp</name></workspace>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:         testServer.URL,
+		HTTPClient:  &http.Client{},
+		RetryPolicy: testBackoffPolicy(),
+	}
+
+	workspace, err := cli.GetWorkspace("topp")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "topp", workspace.Name)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	attempts := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(502)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:         testServer.URL,
+		HTTPClient:  &http.Client{},
+		RetryPolicy: testBackoffPolicy(),
+	}
+
+	_, err := cli.GetWorkspace("topp")
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 502, apiErr.StatusCode)
+	assert.Equal(t, 4, attempts)
+}
+
+func TestRetryResendsBody(t *testing.T) {
+	var payloads []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		payloads = append(payloads, string(rawBody))
+		if len(payloads) == 1 {
+			w.WriteHeader(503)
+			return
+		}
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:         testServer.URL,
+		HTTPClient:  &http.Client{},
+		RetryPolicy: testBackoffPolicy(),
+	}
+
+	err := cli.UpdateWorkspace("topp", &Workspace{Name: "topp"})
+
+	assert.Nil(t, err)
+	assert.Len(t, payloads, 2)
+	assert.Equal(t, payloads[0], payloads[1])
+	assert.NotEmpty(t, payloads[1])
+}
+
+func TestRetryResendsFileBody(t *testing.T) {
+	var payloads []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		payloads = append(payloads, string(rawBody))
+		if len(payloads) == 1 {
+			w.WriteHeader(503)
+			return
+		}
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	file, err := os.CreateTemp(t.TempDir(), "roads-*.zip")
+	assert.Nil(t, err)
+	defer file.Close()
+	_, err = file.WriteString("zipped shapefile")
+	assert.Nil(t, err)
+	_, err = file.Seek(0, io.SeekStart)
+	assert.Nil(t, err)
+
+	cli := &Client{
+		URL:         testServer.URL,
+		HTTPClient:  &http.Client{},
+		RetryPolicy: testBackoffPolicy(),
+	}
+
+	err = cli.UploadDatastore("topp", "roads", "shp", file, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"zipped shapefile", "zipped shapefile"}, payloads)
+}
+
+func TestRetryResendsPartlyReadBody(t *testing.T) {
+	var payloads []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		payloads = append(payloads, string(rawBody))
+		if len(payloads) == 1 {
+			w.WriteHeader(503)
+			return
+		}
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	content := "header;zipped shapefile"
+	body := io.NewSectionReader(strings.NewReader(content), 0, int64(len(content)))
+	_, err := body.Seek(int64(len("header;")), io.SeekStart)
+	assert.Nil(t, err)
+
+	cli := &Client{
+		URL:         testServer.URL,
+		HTTPClient:  &http.Client{},
+		RetryPolicy: testBackoffPolicy(),
+	}
+
+	err = cli.UploadDatastore("topp", "roads", "shp", body, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"zipped shapefile", "zipped shapefile"}, payloads)
+}
+
+func TestRetryIgnoresNonIdempotent(t *testing.T) {
+	attempts := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(503)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:         testServer.URL,
+		HTTPClient:  &http.Client{},
+		RetryPolicy: testBackoffPolicy(),
+	}
+
+	err := cli.CreateWorkspace(&Workspace{Name: "topp"}, false)
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	policy := testBackoffPolicy()
+	policy.MaxDelay = 10 * time.Second
+	request := httptest.NewRequest("GET", "/workspaces", nil)
+	response := &http.Response{
+		StatusCode: 503,
+		Header:     http.Header{"Retry-After": []string{"2"}},
+	}
+
+	delay, retry := policy.Retry(1, request, response, nil)
+
+	assert.True(t, retry)
+	assert.Equal(t, 2*time.Second, delay)
+}
+
+func TestRetryCapsRetryAfter(t *testing.T) {
+	policy := NewBackoffPolicy()
+	request := httptest.NewRequest("GET", "/workspaces", nil)
+	response := &http.Response{
+		StatusCode: 503,
+		Header:     http.Header{"Retry-After": []string{"3600"}},
+	}
+
+	delay, retry := policy.Retry(1, request, response, nil)
+
+	assert.True(t, retry)
+	assert.Equal(t, policy.MaxDelay, delay)
+}
+
+func TestRetryStopsOnContextCancel(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(503)
+	}))
+	defer testServer.Close()
+
+	policy := testBackoffPolicy()
+	policy.MaxDelay = time.Minute
+	cli := &Client{
+		URL:         testServer.URL,
+		HTTPClient:  &http.Client{},
+		RetryPolicy: policy,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := cli.GetWorkspaceContext(ctx, "topp")
+
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
@@ -0,0 +1,217 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Catalog modes, telling how GeoServer answers requests for resources a user
+// cannot access
+const (
+	// CatalogModeHide hides the resources, as if they did not exist
+	CatalogModeHide = "HIDE"
+	// CatalogModeMixed hides the resources from the capabilities but
+	// challenges direct access to them
+	CatalogModeMixed = "MIXED"
+	// CatalogModeChallenge lists the resources and challenges access to them
+	CatalogModeChallenge = "CHALLENGE"
+)
+
+// CatalogMode is the catalog mode of the data security
+type CatalogMode struct {
+	XMLName xml.Name `xml:"catalog"`
+	Mode    string   `xml:"mode"`
+}
+
+// The layer, service and REST ACLs share the representation of LayerRule,
+// their resource attribute being the only difference: "ws.layer.r" for the
+// layers, "service.method" for the services and "pattern:METHODS" for the
+// REST API.
+
+// getACLRules returns the rules of an ACL
+func (c *Client) getACLRules(ctx context.Context, acl string) (rules LayerRules, err error) {
+	endpoint := fmt.Sprintf("/security/acl/%s", acl)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	err = xml.Unmarshal([]byte(body), &rules)
+
+	return
+}
+
+// getACLRule returns the rule of an ACL for the given resource
+func (c *Client) getACLRule(ctx context.Context, acl, resource string) (rule *LayerRule, err error) {
+	rules, err := c.getACLRules(ctx, acl)
+	if err != nil {
+		return
+	}
+
+	ruleIdx := slices.IndexFunc(rules.List, func(rule *LayerRule) bool { return rule.Resource == resource })
+	if ruleIdx == -1 {
+		return rule, fmt.Errorf("rule %s: %w", resource, ErrNotFound)
+	}
+
+	rule = rules.List[ruleIdx]
+	return
+}
+
+// writeACLRule creates (POST) or updates (PUT) the rule of an ACL
+func (c *Client) writeACLRule(ctx context.Context, method, acl string, rule *LayerRule) (err error) {
+	rule.XMLName = xml.Name{
+		Local: "rule",
+	}
+	payload, err := xml.Marshal(LayerRules{List: []*LayerRule{rule}})
+	if err != nil {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/security/acl/%s", acl)
+	statusCode, body, err := c.doFullyTypedRequest(ctx, method, endpoint, bytes.NewBuffer(payload), "application/xml", "")
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError(method, endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 405:
+		err = newAPIError(method, endpoint, statusCode, body, ErrForbidden)
+		return
+	case 409:
+		// GeoServer answers 409 when creating an existing rule, or when
+		// updating a missing one
+		if method == "PUT" {
+			err = newAPIError(method, endpoint, statusCode, body, ErrNotFound)
+		} else {
+			err = newAPIError(method, endpoint, statusCode, body, ErrConflict)
+		}
+		return
+	case 200, 201:
+		return
+	default:
+		err = newAPIError(method, endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// deleteACLRule deletes the rule of an ACL for the given resource
+func (c *Client) deleteACLRule(ctx context.Context, acl, resource string) (err error) {
+	endpoint := fmt.Sprintf("/security/acl/%s/%s", acl, escapeACLResource(resource))
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// GetCatalogMode returns the catalog mode, one of CatalogModeHide,
+// CatalogModeMixed or CatalogModeChallenge
+func (c *Client) GetCatalogMode() (mode string, err error) {
+	return c.GetCatalogModeContext(context.Background())
+}
+
+// GetCatalogModeContext is like GetCatalogMode but carries ctx down to the HTTP requests
+func (c *Client) GetCatalogModeContext(ctx context.Context) (mode string, err error) {
+	endpoint := "/security/acl/catalog"
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data CatalogMode
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return mode, err
+	}
+
+	return data.Mode, nil
+}
+
+// UpdateCatalogMode sets the catalog mode
+func (c *Client) UpdateCatalogMode(mode string) (err error) {
+	return c.UpdateCatalogModeContext(context.Background(), mode)
+}
+
+// UpdateCatalogModeContext is like UpdateCatalogMode but carries ctx down to the HTTP requests
+func (c *Client) UpdateCatalogModeContext(ctx context.Context, mode string) (err error) {
+	payload, err := xml.Marshal(CatalogMode{Mode: mode})
+	if err != nil {
+		return
+	}
+
+	endpoint := "/security/acl/catalog"
+	statusCode, body, err := c.doFullyTypedRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload), "application/xml", "")
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 405:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// escapeACLResource escapes the resource of a rule to be used in a URL path.
+// Its slashes, found in the patterns of the REST API rules, are kept as is.
+func escapeACLResource(resource string) string {
+	segments := strings.Split(resource, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 	"slices"
@@ -22,19 +23,25 @@ type LayerRule struct {
 
 // GetLayerRules returns the list of the layer rules
 func (c *Client) GetLayerRules() (rules LayerRules, err error) {
-	statusCode, body, err := c.doRequest("GET", "/security/acl/layers", nil)
+	return c.GetLayerRulesContext(context.Background())
+}
+
+// GetLayerRulesContext is like GetLayerRules but carries ctx down to the HTTP requests
+func (c *Client) GetLayerRulesContext(ctx context.Context) (rules LayerRules, err error) {
+	endpoint := "/security/acl/layers"
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -48,7 +55,12 @@ func (c *Client) GetLayerRules() (rules LayerRules, err error) {
 
 // GetLayerRule return a rule based on its definition
 func (c *Client) GetLayerRule(ruleDef string) (rule *LayerRule, err error) {
-	rules, rulesErr := c.GetLayerRules()
+	return c.GetLayerRuleContext(context.Background(), ruleDef)
+}
+
+// GetLayerRuleContext is like GetLayerRule but carries ctx down to the HTTP requests
+func (c *Client) GetLayerRuleContext(ctx context.Context, ruleDef string) (rule *LayerRule, err error) {
+	rules, rulesErr := c.GetLayerRulesContext(ctx)
 
 	if rulesErr != nil {
 		return rule, rulesErr
@@ -57,7 +69,7 @@ func (c *Client) GetLayerRule(ruleDef string) (rule *LayerRule, err error) {
 	ruleIdx := slices.IndexFunc(rules.List, func(rule *LayerRule) bool { return rule.Resource == ruleDef })
 
 	if ruleIdx == -1 {
-		return rule, fmt.Errorf("Rule not found")
+		return rule, fmt.Errorf("rule %s: %w", ruleDef, ErrNotFound)
 	}
 
 	rule = rules.List[ruleIdx]
@@ -66,6 +78,11 @@ func (c *Client) GetLayerRule(ruleDef string) (rule *LayerRule, err error) {
 
 // CreateRule creates a ACL rule
 func (c *Client) CreateLayerRule(rule *LayerRule) (err error) {
+	return c.CreateLayerRuleContext(context.Background(), rule)
+}
+
+// CreateLayerRuleContext is like CreateLayerRule but carries ctx down to the HTTP requests
+func (c *Client) CreateLayerRuleContext(ctx context.Context, rule *LayerRule) (err error) {
 	rule.XMLName = xml.Name{
 		Local: "rule",
 	}
@@ -83,7 +100,8 @@ func (c *Client) CreateLayerRule(rule *LayerRule) (err error) {
 	if err != nil {
 		return
 	}
-	statusCode, body, err := c.doFullyTypedRequest("POST", "/security/acl/layers", bytes.NewBuffer(payload), "application/xml", "")
+	endpoint := "/security/acl/layers"
+	statusCode, body, err := c.doFullyTypedRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload), "application/xml", "")
 
 	if err != nil {
 		return
@@ -91,18 +109,25 @@ func (c *Client) CreateLayerRule(rule *LayerRule) (err error) {
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		return
+	case 201:
+		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s - %s", statusCode, body, string(payload))
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateUser creates a user
 func (c *Client) UpdateLayerRule(rule *LayerRule) (err error) {
+	return c.UpdateLayerRuleContext(context.Background(), rule)
+}
+
+// UpdateLayerRuleContext is like UpdateLayerRule but carries ctx down to the HTTP requests
+func (c *Client) UpdateLayerRuleContext(ctx context.Context, rule *LayerRule) (err error) {
 	rule.XMLName = xml.Name{
 		Local: "rule",
 	}
@@ -120,7 +145,8 @@ func (c *Client) UpdateLayerRule(rule *LayerRule) (err error) {
 	if err != nil {
 		return
 	}
-	statusCode, body, err := c.doFullyTypedRequest("PUT", "/security/acl/layers", bytes.NewBuffer(payload), "application/xml", "")
+	endpoint := "/security/acl/layers"
+	statusCode, body, err := c.doFullyTypedRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload), "application/xml", "")
 
 	if err != nil {
 		return
@@ -128,48 +154,53 @@ func (c *Client) UpdateLayerRule(rule *LayerRule) (err error) {
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 409:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteLayerRule deletes ACL Rule from GeoServer
 func (c *Client) DeleteLayerRule(ruleDefinition string) (err error) {
+	return c.DeleteLayerRuleContext(context.Background(), ruleDefinition)
+}
+
+// DeleteLayerRuleContext is like DeleteLayerRule but carries ctx down to the HTTP requests
+func (c *Client) DeleteLayerRuleContext(ctx context.Context, ruleDefinition string) (err error) {
 	var endpoint string = fmt.Sprintf("/security/acl/layers/%s", ruleDefinition)
 
-	statusCode, body, err := c.doRequest("DELETE", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("service name is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
@@ -0,0 +1,235 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// AccessMode is the kind of access a layer rule grants
+type AccessMode string
+
+const (
+	// AccessRead grants reading the data
+	AccessRead AccessMode = "r"
+	// AccessWrite grants modifying the data
+	AccessWrite AccessMode = "w"
+	// AccessAdmin grants administrating the workspace
+	AccessAdmin AccessMode = "a"
+)
+
+// AnyRole is the role standing for everybody, anonymous users included
+const AnyRole = "*"
+
+// LayerRuleDefinition is the structured form of a LayerRule
+type LayerRuleDefinition struct {
+	// Workspace is the name of the workspace, "*" for all of them, or empty
+	// for a global layer group
+	Workspace string
+	// Layer is the name of the layer or layer group, "*" for all of them
+	Layer string
+	Mode  AccessMode
+	Roles []string
+}
+
+// ParseLayerRule parses the resource and the roles of a layer rule
+func ParseLayerRule(rule *LayerRule) (definition *LayerRuleDefinition, err error) {
+	elements := splitRuleResource(rule.Resource)
+
+	definition = &LayerRuleDefinition{}
+	switch len(elements) {
+	case 2:
+		definition.Layer = elements[0]
+	case 3:
+		definition.Workspace = elements[0]
+		definition.Layer = elements[1]
+	default:
+		return nil, fmt.Errorf("invalid layer rule %q: expected workspace.layer.mode", rule.Resource)
+	}
+
+	definition.Mode = AccessMode(elements[len(elements)-1])
+	switch definition.Mode {
+	case AccessRead, AccessWrite, AccessAdmin:
+	default:
+		return nil, fmt.Errorf("invalid layer rule %q: unknown access mode %s", rule.Resource, definition.Mode)
+	}
+
+	for _, role := range strings.Split(rule.Rule, ",") {
+		role = strings.TrimSpace(role)
+		if role != "" && !slices.Contains(definition.Roles, role) {
+			definition.Roles = append(definition.Roles, role)
+		}
+	}
+
+	return
+}
+
+// splitRuleResource splits a rule resource on its dots, a dot escaped with
+// a backslash being part of the name
+func splitRuleResource(resource string) (elements []string) {
+	var element strings.Builder
+	for i := 0; i < len(resource); i++ {
+		switch {
+		case resource[i] == '\\' && i+1 < len(resource) && resource[i+1] == '.':
+			element.WriteByte('.')
+			i++
+		case resource[i] == '.':
+			elements = append(elements, element.String())
+			element.Reset()
+		default:
+			element.WriteByte(resource[i])
+		}
+	}
+
+	return append(elements, element.String())
+}
+
+// Resource formats the resource of the rule, e.g. "topp.states.r"
+func (d *LayerRuleDefinition) Resource() string {
+	escape := strings.NewReplacer(".", `\.`)
+
+	if d.Workspace == "" {
+		return fmt.Sprintf("%s.%s", escape.Replace(d.Layer), d.Mode)
+	}
+
+	return fmt.Sprintf("%s.%s.%s", escape.Replace(d.Workspace), escape.Replace(d.Layer), d.Mode)
+}
+
+// LayerRule formats the rule
+func (d *LayerRuleDefinition) LayerRule() *LayerRule {
+	return &LayerRule{
+		Resource: d.Resource(),
+		Rule:     strings.Join(d.Roles, ","),
+	}
+}
+
+// Grant adds a role to the rule, telling whether it was missing
+func (d *LayerRuleDefinition) Grant(role string) bool {
+	if slices.Contains(d.Roles, role) {
+		return false
+	}
+
+	d.Roles = append(d.Roles, role)
+	return true
+}
+
+// Revoke removes a role from the rule, telling whether it was present
+func (d *LayerRuleDefinition) Revoke(role string) bool {
+	idx := slices.Index(d.Roles, role)
+	if idx == -1 {
+		return false
+	}
+
+	d.Roles = slices.Delete(d.Roles, idx, idx+1)
+	return true
+}
+
+// matches tells whether the rule applies to the layer, and how specific it
+// is: 2 for the layer itself, 1 for all the layers of its workspace and 0 for
+// all the layers
+func (d *LayerRuleDefinition) matches(workspace, layer string) (specificity int, ok bool) {
+	switch {
+	case d.Workspace == workspace && d.Layer == layer:
+		return 2, true
+	case d.Workspace == workspace && d.Layer == "*":
+		return 1, true
+	case d.Workspace == "*" && d.Layer == "*":
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// EffectiveReaders returns the roles allowed to read a layer, or a layer
+// group when workspace is empty for a global one. Like GeoServer, the most
+// specific read rule applies: the one of the layer, else the one of its
+// workspace, else the one of all the layers. Every role can read a layer no
+// rule applies to, which is returned as AnyRole.
+func (rules LayerRules) EffectiveReaders(workspace, layer string) (roles []string, err error) {
+	specificity := -1
+	roles = []string{AnyRole}
+
+	for _, rule := range rules.List {
+		definition, parseErr := ParseLayerRule(rule)
+		if parseErr != nil {
+			err = errors.Join(err, parseErr)
+			continue
+		}
+		if definition.Mode != AccessRead {
+			continue
+		}
+
+		if s, ok := definition.matches(workspace, layer); ok && s > specificity {
+			specificity = s
+			roles = definition.Roles
+		}
+	}
+
+	return
+}
+
+// GrantLayerRule grants a role the access given by the resource of a layer
+// rule, e.g. "topp.states.r", keeping the roles already granted. The rule is
+// created when it does not exist.
+func (c *Client) GrantLayerRule(resource, role string) (err error) {
+	return c.GrantLayerRuleContext(context.Background(), resource, role)
+}
+
+// GrantLayerRuleContext is like GrantLayerRule but carries ctx down to the HTTP requests
+func (c *Client) GrantLayerRuleContext(ctx context.Context, resource, role string) (err error) {
+	rule, err := c.GetLayerRuleContext(ctx, resource)
+	if errors.Is(err, ErrNotFound) {
+		return c.CreateLayerRuleContext(ctx, &LayerRule{Resource: resource, Rule: role})
+	}
+	if err != nil {
+		return
+	}
+
+	definition, err := ParseLayerRule(rule)
+	if err != nil {
+		return
+	}
+	if !definition.Grant(role) {
+		return
+	}
+
+	rule.Rule = strings.Join(definition.Roles, ",")
+	return c.UpdateLayerRuleContext(ctx, rule)
+}
+
+// RevokeLayerRule revokes a role from a layer rule, keeping the other roles.
+// Revoking the last role is an error: the rule would have to be deleted, and
+// the layer would then fall back to a less specific rule, e.g. "*.*.r=*",
+// opening it to more roles instead of fewer. DeleteLayerRule deletes a rule
+// on purpose.
+func (c *Client) RevokeLayerRule(resource, role string) (err error) {
+	return c.RevokeLayerRuleContext(context.Background(), resource, role)
+}
+
+// RevokeLayerRuleContext is like RevokeLayerRule but carries ctx down to the HTTP requests
+func (c *Client) RevokeLayerRuleContext(ctx context.Context, resource, role string) (err error) {
+	rule, err := c.GetLayerRuleContext(ctx, resource)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return
+	}
+
+	definition, err := ParseLayerRule(rule)
+	if err != nil {
+		return
+	}
+	if !definition.Revoke(role) {
+		return
+	}
+
+	if len(definition.Roles) == 0 {
+		return fmt.Errorf("cannot revoke the last role %s of rule %s", role, rule.Resource)
+	}
+
+	rule.Rule = strings.Join(definition.Roles, ",")
+	return c.UpdateLayerRuleContext(ctx, rule)
+}
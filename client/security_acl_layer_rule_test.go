@@ -0,0 +1,198 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLayerRule(t *testing.T) {
+	definition, err := ParseLayerRule(&LayerRule{Resource: "topp.states.r", Rule: "ROLE_A, ROLE_B,ROLE_A"})
+	assert.Nil(t, err)
+	assert.Equal(t, &LayerRuleDefinition{
+		Workspace: "topp",
+		Layer:     "states",
+		Mode:      AccessRead,
+		Roles:     []string{"ROLE_A", "ROLE_B"},
+	}, definition)
+
+	definition, err = ParseLayerRule(&LayerRule{Resource: `topp.roads\.v2.w`, Rule: "*"})
+	assert.Nil(t, err)
+	assert.Equal(t, "roads.v2", definition.Layer)
+	assert.Equal(t, AccessWrite, definition.Mode)
+	assert.Equal(t, `topp.roads\.v2.w`, definition.Resource())
+
+	definition, err = ParseLayerRule(&LayerRule{Resource: "basemap.r", Rule: "ROLE_A"})
+	assert.Nil(t, err)
+	assert.Equal(t, "", definition.Workspace)
+	assert.Equal(t, "basemap", definition.Layer)
+	assert.Equal(t, "basemap.r", definition.Resource())
+
+	_, err = ParseLayerRule(&LayerRule{Resource: "topp.states.x", Rule: "ROLE_A"})
+	assert.NotNil(t, err)
+
+	_, err = ParseLayerRule(&LayerRule{Resource: "states", Rule: "ROLE_A"})
+	assert.NotNil(t, err)
+}
+
+func TestLayerRuleDefinitionGrantRevoke(t *testing.T) {
+	definition := &LayerRuleDefinition{Workspace: "topp", Layer: "*", Mode: AccessAdmin, Roles: []string{"ROLE_A"}}
+
+	assert.True(t, definition.Grant("ROLE_B"))
+	assert.False(t, definition.Grant("ROLE_A"))
+	assert.Equal(t, &LayerRule{Resource: "topp.*.a", Rule: "ROLE_A,ROLE_B"}, definition.LayerRule())
+
+	assert.True(t, definition.Revoke("ROLE_A"))
+	assert.False(t, definition.Revoke("ROLE_C"))
+	assert.Equal(t, []string{"ROLE_B"}, definition.Roles)
+}
+
+func TestEffectiveReaders(t *testing.T) {
+	rules := LayerRules{List: []*LayerRule{
+		{Resource: "*.*.r", Rule: "*"},
+		{Resource: "*.*.w", Rule: "ADMIN"},
+		{Resource: "topp.*.r", Rule: "ROLE_TOPP"},
+		{Resource: "topp.states.r", Rule: "ROLE_STATES,ROLE_TOPP"},
+		{Resource: "topp.states.w", Rule: "ROLE_EDITOR"},
+		{Resource: "basemap.r", Rule: "ROLE_BASEMAP"},
+	}}
+
+	roles, err := rules.EffectiveReaders("topp", "states")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ROLE_STATES", "ROLE_TOPP"}, roles)
+
+	roles, err = rules.EffectiveReaders("topp", "roads")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ROLE_TOPP"}, roles)
+
+	roles, err = rules.EffectiveReaders("sf", "roads")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{AnyRole}, roles)
+
+	roles, err = rules.EffectiveReaders("", "basemap")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ROLE_BASEMAP"}, roles)
+
+	roles, err = LayerRules{}.EffectiveReaders("sf", "roads")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{AnyRole}, roles)
+}
+
+func TestGrantLayerRule(t *testing.T) {
+	var calls []string
+	var written string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(200)
+			w.Write([]byte(`<rules><rule resource="topp.states.r">ROLE_A</rule></rules>`))
+		default:
+			rawBody, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			written = string(rawBody)
+			w.WriteHeader(200)
+		}
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.GrantLayerRule("topp.states.r", "ROLE_B")
+	assert.Nil(t, err)
+	assert.Equal(t, `<rules><rule resource="topp.states.r">ROLE_A,ROLE_B</rule></rules>`, written)
+
+	err = cli.GrantLayerRule("topp.roads.r", "ROLE_B")
+	assert.Nil(t, err)
+	assert.Equal(t, `<rules><rule resource="topp.roads.r">ROLE_B</rule></rules>`, written)
+
+	calls = nil
+	err = cli.GrantLayerRule("topp.states.r", "ROLE_A")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GET /security/acl/layers"}, calls)
+}
+
+func TestRevokeLayerRule(t *testing.T) {
+	var calls []string
+	var written string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(200)
+			w.Write([]byte(`<rules><rule resource="topp.states.r">ROLE_A,ROLE_B</rule><rule resource="topp.roads.r">ROLE_B</rule></rules>`))
+		default:
+			rawBody, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			written = string(rawBody)
+			w.WriteHeader(200)
+		}
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.RevokeLayerRule("topp.states.r", "ROLE_A")
+	assert.Nil(t, err)
+	assert.Equal(t, `<rules><rule resource="topp.states.r">ROLE_B</rule></rules>`, written)
+
+	calls = nil
+	err = cli.RevokeLayerRule("topp.roads.r", "ROLE_B")
+	assert.EqualError(t, err, "cannot revoke the last role ROLE_B of rule topp.roads.r")
+	assert.Equal(t, []string{"GET /security/acl/layers"}, calls)
+
+	calls = nil
+	err = cli.RevokeLayerRule("topp.lakes.r", "ROLE_B")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GET /security/acl/layers"}, calls)
+}
+
+func TestRevokeLastRoleWouldOpenLayer(t *testing.T) {
+	rules := LayerRules{List: []*LayerRule{
+		{Resource: "*.*.r", Rule: "*"},
+		{Resource: "topp.roads.r", Rule: "ROLE_B"},
+	}}
+
+	roles, err := rules.EffectiveReaders("topp", "roads")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ROLE_B"}, roles)
+
+	// Deleting the rule instead of keeping it would let anyone read the layer
+	rules.List = rules.List[:1]
+	roles, err = rules.EffectiveReaders("topp", "roads")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{AnyRole}, roles)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<rules><rule resource="*.*.r">*</rule><rule resource="topp.roads.r">ROLE_B</rule></rules>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err = cli.RevokeLayerRule("topp.roads.r", "ROLE_B")
+	assert.NotNil(t, err)
+
+	current, err := cli.GetLayerRules()
+	assert.Nil(t, err)
+	roles, err = current.EffectiveReaders("topp", "roads")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ROLE_B"}, roles)
+}
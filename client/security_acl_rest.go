@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"strings"
+)
+
+// NewRestRule returns the rule granting roles the access to the REST API
+// paths matching an Ant pattern, e.g. "/rest/workspaces/**", with the given
+// HTTP methods
+func NewRestRule(pattern string, methods []string, roles ...string) *LayerRule {
+	return &LayerRule{
+		Resource: pattern + ":" + strings.Join(methods, ","),
+		Rule:     strings.Join(roles, ","),
+	}
+}
+
+// GetRestRules returns the list of the REST API rules
+func (c *Client) GetRestRules() (rules LayerRules, err error) {
+	return c.GetRestRulesContext(context.Background())
+}
+
+// GetRestRulesContext is like GetRestRules but carries ctx down to the HTTP requests
+func (c *Client) GetRestRulesContext(ctx context.Context) (rules LayerRules, err error) {
+	return c.getACLRules(ctx, "rest")
+}
+
+// GetRestRule return a REST API rule based on its resource, e.g.
+// "/rest/**:GET"
+func (c *Client) GetRestRule(resource string) (rule *LayerRule, err error) {
+	return c.GetRestRuleContext(context.Background(), resource)
+}
+
+// GetRestRuleContext is like GetRestRule but carries ctx down to the HTTP requests
+func (c *Client) GetRestRuleContext(ctx context.Context, resource string) (rule *LayerRule, err error) {
+	return c.getACLRule(ctx, "rest", resource)
+}
+
+// CreateRestRule creates a REST API rule
+func (c *Client) CreateRestRule(rule *LayerRule) (err error) {
+	return c.CreateRestRuleContext(context.Background(), rule)
+}
+
+// CreateRestRuleContext is like CreateRestRule but carries ctx down to the HTTP requests
+func (c *Client) CreateRestRuleContext(ctx context.Context, rule *LayerRule) (err error) {
+	return c.writeACLRule(ctx, "POST", "rest", rule)
+}
+
+// UpdateRestRule updates the roles of a REST API rule
+func (c *Client) UpdateRestRule(rule *LayerRule) (err error) {
+	return c.UpdateRestRuleContext(context.Background(), rule)
+}
+
+// UpdateRestRuleContext is like UpdateRestRule but carries ctx down to the HTTP requests
+func (c *Client) UpdateRestRuleContext(ctx context.Context, rule *LayerRule) (err error) {
+	return c.writeACLRule(ctx, "PUT", "rest", rule)
+}
+
+// DeleteRestRule deletes a REST API rule based on its resource
+func (c *Client) DeleteRestRule(resource string) (err error) {
+	return c.DeleteRestRuleContext(context.Background(), resource)
+}
+
+// DeleteRestRuleContext is like DeleteRestRule but carries ctx down to the HTTP requests
+func (c *Client) DeleteRestRuleContext(ctx context.Context, resource string) (err error) {
+	return c.deleteACLRule(ctx, "rest", resource)
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRestRuleSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/acl/rest")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<rules>
+			<rule resource="/**:GET">ADMIN</rule>
+			<rule resource="/**:POST,DELETE,PUT">ADMIN</rule>
+		</rules>
+		`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	rule, err := cli.GetRestRule("/**:POST,DELETE,PUT")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "ADMIN", rule.Rule)
+}
+
+func TestUpdateRestRuleSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/security/acl/rest")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<rules><rule resource="/rest/workspaces/**:GET,PUT">ROLE_PUBLISHER</rule></rules>`, string(rawBody))
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateRestRule(NewRestRule("/rest/workspaces/**", []string{"GET", "PUT"}, "ROLE_PUBLISHER"))
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteRestRuleEscapesResource(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.Path, "/security/acl/rest//rest/workspaces/**:GET,PUT")
+		assert.Equal(t, r.URL.EscapedPath(), "/security/acl/rest//rest/workspaces/%2A%2A:GET%2CPUT")
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteRestRule("/rest/workspaces/**:GET,PUT")
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteRestRuleRootPattern(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.EscapedPath(), "/security/acl/rest//%2A%2A:GET")
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteRestRule("/**:GET")
+
+	assert.Nil(t, err)
+}
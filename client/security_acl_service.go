@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"strings"
+)
+
+// NewServiceRule returns the rule granting roles the access to a method of
+// a service, e.g. "wfs" and "Transaction". Both can be "*".
+func NewServiceRule(service, method string, roles ...string) *LayerRule {
+	return &LayerRule{
+		Resource: service + "." + method,
+		Rule:     strings.Join(roles, ","),
+	}
+}
+
+// GetServiceRules returns the list of the service rules
+func (c *Client) GetServiceRules() (rules LayerRules, err error) {
+	return c.GetServiceRulesContext(context.Background())
+}
+
+// GetServiceRulesContext is like GetServiceRules but carries ctx down to the HTTP requests
+func (c *Client) GetServiceRulesContext(ctx context.Context) (rules LayerRules, err error) {
+	return c.getACLRules(ctx, "services")
+}
+
+// GetServiceRule return a service rule based on its resource, e.g.
+// "wfs.Transaction"
+func (c *Client) GetServiceRule(resource string) (rule *LayerRule, err error) {
+	return c.GetServiceRuleContext(context.Background(), resource)
+}
+
+// GetServiceRuleContext is like GetServiceRule but carries ctx down to the HTTP requests
+func (c *Client) GetServiceRuleContext(ctx context.Context, resource string) (rule *LayerRule, err error) {
+	return c.getACLRule(ctx, "services", resource)
+}
+
+// CreateServiceRule creates a service rule
+func (c *Client) CreateServiceRule(rule *LayerRule) (err error) {
+	return c.CreateServiceRuleContext(context.Background(), rule)
+}
+
+// CreateServiceRuleContext is like CreateServiceRule but carries ctx down to the HTTP requests
+func (c *Client) CreateServiceRuleContext(ctx context.Context, rule *LayerRule) (err error) {
+	return c.writeACLRule(ctx, "POST", "services", rule)
+}
+
+// UpdateServiceRule updates the roles of a service rule
+func (c *Client) UpdateServiceRule(rule *LayerRule) (err error) {
+	return c.UpdateServiceRuleContext(context.Background(), rule)
+}
+
+// UpdateServiceRuleContext is like UpdateServiceRule but carries ctx down to the HTTP requests
+func (c *Client) UpdateServiceRuleContext(ctx context.Context, rule *LayerRule) (err error) {
+	return c.writeACLRule(ctx, "PUT", "services", rule)
+}
+
+// DeleteServiceRule deletes a service rule based on its resource
+func (c *Client) DeleteServiceRule(resource string) (err error) {
+	return c.DeleteServiceRuleContext(context.Background(), resource)
+}
+
+// DeleteServiceRuleContext is like DeleteServiceRule but carries ctx down to the HTTP requests
+func (c *Client) DeleteServiceRuleContext(ctx context.Context, resource string) (err error) {
+	return c.deleteACLRule(ctx, "services", resource)
+}
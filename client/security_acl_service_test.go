@@ -0,0 +1,124 @@
+package client
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetServiceRulesSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/acl/services")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<rules>
+			<rule resource="wfs.Transaction">ROLE_EDITOR</rule>
+			<rule resource="*.*">*</rule>
+		</rules>
+		`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	rules, err := cli.GetServiceRules()
+	assert.Nil(t, err)
+	assert.Len(t, rules.List, 2)
+
+	rule, err := cli.GetServiceRule("wfs.Transaction")
+	assert.Nil(t, err)
+	assert.Equal(t, &LayerRule{
+		XMLName:  xml.Name{Local: "rule"},
+		Resource: "wfs.Transaction",
+		Rule:     "ROLE_EDITOR",
+	}, rule)
+
+	_, err = cli.GetServiceRule("wms.GetMap")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestCreateServiceRuleSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/security/acl/services")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<rules><rule resource="wfs.Transaction">ROLE_EDITOR,ADMIN</rule></rules>`, string(rawBody))
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateServiceRule(NewServiceRule("wfs", "Transaction", "ROLE_EDITOR", "ADMIN"))
+
+	assert.Nil(t, err)
+}
+
+func TestCreateServiceRuleConflict(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(409)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateServiceRule(NewServiceRule("wfs", "Transaction", "ROLE_EDITOR"))
+
+	assert.True(t, errors.Is(err, ErrConflict))
+}
+
+func TestUpdateServiceRuleNotFound(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+
+		w.WriteHeader(409)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateServiceRule(NewServiceRule("wfs", "Transaction", "ROLE_EDITOR"))
+
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestDeleteServiceRuleSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.Path, "/security/acl/services/wfs.Transaction")
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteServiceRule("wfs.Transaction")
+
+	assert.Nil(t, err)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCatalogModeSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/acl/catalog")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<catalog><mode>MIXED</mode></catalog>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	mode, err := cli.GetCatalogMode()
+
+	assert.Nil(t, err)
+	assert.Equal(t, CatalogModeMixed, mode)
+}
+
+func TestUpdateCatalogModeSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/security/acl/catalog")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<catalog><mode>CHALLENGE</mode></catalog>`, string(rawBody))
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateCatalogMode(CatalogModeChallenge)
+
+	assert.Nil(t, err)
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+)
+
+// Configuration classes and implementation classes of the authentication
+// filters shipped with GeoServer
+const (
+	AuthFilterConfigBasic         = "org.geoserver.security.config.BasicAuthenticationFilterConfig"
+	AuthFilterClassBasic          = "org.geoserver.security.filter.GeoServerBasicAuthenticationFilter"
+	AuthFilterConfigForm          = "org.geoserver.security.config.UsernamePasswordAuthenticationFilterConfig"
+	AuthFilterClassForm           = "org.geoserver.security.filter.GeoServerUserNamePasswordAuthenticationFilter"
+	AuthFilterConfigRequestHeader = "org.geoserver.security.config.RequestHeaderAuthenticationFilterConfig"
+	AuthFilterClassRequestHeader  = "org.geoserver.security.filter.GeoServerRequestHeaderAuthenticationFilter"
+	AuthFilterConfigAnonymous     = "org.geoserver.security.config.AnonymousAuthenticationFilterConfig"
+	AuthFilterClassAnonymous      = "org.geoserver.security.filter.GeoServerAnonymousAuthenticationFilter"
+)
+
+// Sources of the roles of a pre-authenticated user
+const (
+	RoleSourceUserGroupService = "UserGroupService"
+	RoleSourceRoleService      = "RoleService"
+	RoleSourceHeader           = "Header"
+)
+
+// AuthFilter is the configuration of an authentication filter
+type AuthFilter struct {
+	// ConfigClass is the class of the configuration, e.g.
+	// AuthFilterConfigRequestHeader, used as the root element of the XML
+	ConfigClass string `xml:"-"`
+	ID          string `xml:"id,omitempty"`
+	Name        string `xml:"name"`
+	ClassName   string `xml:"className"`
+	// Basic
+	UseRememberMe bool `xml:"useRememberMe,omitempty"`
+	// Form
+	UsernameParameterName string `xml:"usernameParameterName,omitempty"`
+	PasswordParameterName string `xml:"passwordParameterName,omitempty"`
+	// Pre-authentication, e.g. request header
+	PrincipalHeaderAttribute string `xml:"principalHeaderAttribute,omitempty"`
+	RoleSource               string `xml:"roleSource,omitempty"`
+	UserGroupServiceName     string `xml:"userGroupServiceName,omitempty"`
+	RoleServiceName          string `xml:"roleServiceName,omitempty"`
+	RolesHeaderAttribute     string `xml:"rolesHeaderAttribute,omitempty"`
+	RoleConverterName        string `xml:"roleConverterName,omitempty"`
+	// Properties are the properties of other filters
+	Properties []*SecurityConfigProperty `xml:",any"`
+}
+
+// MarshalXML writes the filter with its configuration class as root element
+func (f *AuthFilter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain AuthFilter
+	start.Name = xml.Name{Local: f.ConfigClass}
+	return e.EncodeElement((*plain)(f), start)
+}
+
+// UnmarshalXML reads the filter, keeping its configuration class
+func (f *AuthFilter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain AuthFilter
+	if err := d.DecodeElement((*plain)(f), &start); err != nil {
+		return err
+	}
+	f.ConfigClass = start.Name.Local
+	return nil
+}
+
+// GetAuthFilterNames returns the names of the authentication filters
+func (c *Client) GetAuthFilterNames() (names []string, err error) {
+	return c.GetAuthFilterNamesContext(context.Background())
+}
+
+// GetAuthFilterNamesContext is like GetAuthFilterNames but carries ctx down to the HTTP requests
+func (c *Client) GetAuthFilterNamesContext(ctx context.Context) (names []string, err error) {
+	return c.getSecurityConfigNames(ctx, "/security/authfilters")
+}
+
+// GetAuthFilter returns an authentication filter based on its name
+func (c *Client) GetAuthFilter(name string) (filter *AuthFilter, err error) {
+	return c.GetAuthFilterContext(context.Background(), name)
+}
+
+// GetAuthFilterContext is like GetAuthFilter but carries ctx down to the HTTP requests
+func (c *Client) GetAuthFilterContext(ctx context.Context, name string) (filter *AuthFilter, err error) {
+	var data AuthFilter
+	if err = c.getSecurityConfig(ctx, fmt.Sprintf("/security/authfilters/%s", name), &data); err != nil {
+		return
+	}
+
+	filter = &data
+
+	return
+}
+
+// CreateAuthFilter creates an authentication filter
+func (c *Client) CreateAuthFilter(filter *AuthFilter) (err error) {
+	return c.CreateAuthFilterContext(context.Background(), filter)
+}
+
+// CreateAuthFilterContext is like CreateAuthFilter but carries ctx down to the HTTP requests
+func (c *Client) CreateAuthFilterContext(ctx context.Context, filter *AuthFilter) (err error) {
+	return c.writeSecurityConfig(ctx, "POST", "/security/authfilters", filter)
+}
+
+// UpdateAuthFilter updates an authentication filter
+func (c *Client) UpdateAuthFilter(name string, filter *AuthFilter) (err error) {
+	return c.UpdateAuthFilterContext(context.Background(), name, filter)
+}
+
+// UpdateAuthFilterContext is like UpdateAuthFilter but carries ctx down to the HTTP requests
+func (c *Client) UpdateAuthFilterContext(ctx context.Context, name string, filter *AuthFilter) (err error) {
+	return c.writeSecurityConfig(ctx, "PUT", fmt.Sprintf("/security/authfilters/%s", name), filter)
+}
+
+// DeleteAuthFilter deletes an authentication filter, which must not be used
+// by any filter chain
+func (c *Client) DeleteAuthFilter(name string) (err error) {
+	return c.DeleteAuthFilterContext(context.Background(), name)
+}
+
+// DeleteAuthFilterContext is like DeleteAuthFilter but carries ctx down to the HTTP requests
+func (c *Client) DeleteAuthFilterContext(ctx context.Context, name string) (err error) {
+	return c.deleteSecurityConfig(ctx, fmt.Sprintf("/security/authfilters/%s", name))
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAuthFilterSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/authfilters/proxy")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<org.geoserver.security.config.RequestHeaderAuthenticationFilterConfig>
+<id>52857278:13c7ffd66a8:-7ffd</id>
+<name>proxy</name>
+<className>org.geoserver.security.filter.GeoServerRequestHeaderAuthenticationFilter</className>
+<roleSource class="org.geoserver.security.config.PreAuthenticatedUserNameFilterConfig$PreAuthenticatedUserNameRoleSource">Header</roleSource>
+<principalHeaderAttribute>sec-username</principalHeaderAttribute>
+<rolesHeaderAttribute>sec-roles</rolesHeaderAttribute>
+<roleConverterName>converter</roleConverterName>
+</org.geoserver.security.config.RequestHeaderAuthenticationFilterConfig>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	filter, err := cli.GetAuthFilter("proxy")
+
+	assert.Nil(t, err)
+	assert.Equal(t, &AuthFilter{
+		ConfigClass:              AuthFilterConfigRequestHeader,
+		ID:                       "52857278:13c7ffd66a8:-7ffd",
+		Name:                     "proxy",
+		ClassName:                AuthFilterClassRequestHeader,
+		RoleSource:               RoleSourceHeader,
+		PrincipalHeaderAttribute: "sec-username",
+		RolesHeaderAttribute:     "sec-roles",
+		RoleConverterName:        "converter",
+	}, filter)
+}
+
+func TestCreateAuthFilterSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/security/authfilters")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<org.geoserver.security.config.BasicAuthenticationFilterConfig><name>basic</name><className>org.geoserver.security.filter.GeoServerBasicAuthenticationFilter</className><useRememberMe>true</useRememberMe></org.geoserver.security.config.BasicAuthenticationFilterConfig>`, string(rawBody))
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateAuthFilter(&AuthFilter{
+		ConfigClass:   AuthFilterConfigBasic,
+		Name:          "basic",
+		ClassName:     AuthFilterClassBasic,
+		UseRememberMe: true,
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestAuthFilterRequests(t *testing.T) {
+	var calls []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<authFilters><authFilter><name>anonymous</name></authFilter><authFilter><name>basic</name></authFilter></authFilters>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	names, err := cli.GetAuthFilterNames()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"anonymous", "basic"}, names)
+	assert.Nil(t, cli.UpdateAuthFilter("anonymous", &AuthFilter{
+		ConfigClass: AuthFilterConfigAnonymous,
+		Name:        "anonymous",
+		ClassName:   AuthFilterClassAnonymous,
+	}))
+	assert.Nil(t, cli.DeleteAuthFilter("basic"))
+	assert.Equal(t, []string{
+		"GET /security/authfilters",
+		"PUT /security/authfilters/anonymous",
+		"DELETE /security/authfilters/basic",
+	}, calls)
+}
+
+func TestDeleteAuthFilterInUse(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(403)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteAuthFilter("basic")
+
+	assert.True(t, errors.Is(err, ErrNotEmpty))
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+)
+
+// Configuration classes and implementation classes of the authentication
+// providers shipped with GeoServer
+const (
+	AuthProviderConfigUsernamePassword = "org.geoserver.security.config.UsernamePasswordAuthenticationProviderConfig"
+	AuthProviderClassUsernamePassword  = "org.geoserver.security.auth.UsernamePasswordAuthenticationProvider"
+	AuthProviderConfigLDAP             = "org.geoserver.security.ldap.LDAPSecurityServiceConfig"
+	AuthProviderClassLDAP              = "org.geoserver.security.ldap.LDAPAuthenticationProvider"
+)
+
+// AuthProvider is the configuration of an authentication provider
+type AuthProvider struct {
+	// ConfigClass is the class of the configuration, e.g.
+	// AuthProviderConfigLDAP, used as the root element of the XML
+	ConfigClass          string `xml:"-"`
+	ID                   string `xml:"id,omitempty"`
+	Name                 string `xml:"name"`
+	ClassName            string `xml:"className"`
+	UserGroupServiceName string `xml:"userGroupServiceName,omitempty"`
+	// LDAP
+	ServerURL             string `xml:"serverURL,omitempty"`
+	UseTLS                bool   `xml:"useTLS,omitempty"`
+	UserDnPattern         string `xml:"userDnPattern,omitempty"`
+	UserFilter            string `xml:"userFilter,omitempty"`
+	UserFormat            string `xml:"userFormat,omitempty"`
+	GroupSearchBase       string `xml:"groupSearchBase,omitempty"`
+	GroupSearchFilter     string `xml:"groupSearchFilter,omitempty"`
+	BindBeforeGroupSearch bool   `xml:"bindBeforeGroupSearch,omitempty"`
+	AdminGroup            string `xml:"adminGroup,omitempty"`
+	GroupAdminGroup       string `xml:"groupAdminGroup,omitempty"`
+	User                  string `xml:"user,omitempty"`
+	Password              string `xml:"password,omitempty"`
+	// Properties are the properties of other providers
+	Properties []*SecurityConfigProperty `xml:",any"`
+}
+
+// MarshalXML writes the provider with its configuration class as root
+// element
+func (p *AuthProvider) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain AuthProvider
+	start.Name = xml.Name{Local: p.ConfigClass}
+	return e.EncodeElement((*plain)(p), start)
+}
+
+// UnmarshalXML reads the provider, keeping its configuration class
+func (p *AuthProvider) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain AuthProvider
+	if err := d.DecodeElement((*plain)(p), &start); err != nil {
+		return err
+	}
+	p.ConfigClass = start.Name.Local
+	return nil
+}
+
+// GetAuthProviderNames returns the names of the authentication providers, in
+// the order they are tried
+func (c *Client) GetAuthProviderNames() (names []string, err error) {
+	return c.GetAuthProviderNamesContext(context.Background())
+}
+
+// GetAuthProviderNamesContext is like GetAuthProviderNames but carries ctx down to the HTTP requests
+func (c *Client) GetAuthProviderNamesContext(ctx context.Context) (names []string, err error) {
+	return c.getSecurityConfigNames(ctx, "/security/authproviders")
+}
+
+// GetAuthProvider returns an authentication provider based on its name
+func (c *Client) GetAuthProvider(name string) (provider *AuthProvider, err error) {
+	return c.GetAuthProviderContext(context.Background(), name)
+}
+
+// GetAuthProviderContext is like GetAuthProvider but carries ctx down to the HTTP requests
+func (c *Client) GetAuthProviderContext(ctx context.Context, name string) (provider *AuthProvider, err error) {
+	var data AuthProvider
+	if err = c.getSecurityConfig(ctx, fmt.Sprintf("/security/authproviders/%s", name), &data); err != nil {
+		return
+	}
+
+	provider = &data
+
+	return
+}
+
+// CreateAuthProvider creates an authentication provider
+func (c *Client) CreateAuthProvider(provider *AuthProvider) (err error) {
+	return c.CreateAuthProviderContext(context.Background(), provider)
+}
+
+// CreateAuthProviderContext is like CreateAuthProvider but carries ctx down to the HTTP requests
+func (c *Client) CreateAuthProviderContext(ctx context.Context, provider *AuthProvider) (err error) {
+	return c.writeSecurityConfig(ctx, "POST", "/security/authproviders", provider)
+}
+
+// UpdateAuthProvider updates an authentication provider
+func (c *Client) UpdateAuthProvider(name string, provider *AuthProvider) (err error) {
+	return c.UpdateAuthProviderContext(context.Background(), name, provider)
+}
+
+// UpdateAuthProviderContext is like UpdateAuthProvider but carries ctx down to the HTTP requests
+func (c *Client) UpdateAuthProviderContext(ctx context.Context, name string, provider *AuthProvider) (err error) {
+	return c.writeSecurityConfig(ctx, "PUT", fmt.Sprintf("/security/authproviders/%s", name), provider)
+}
+
+// DeleteAuthProvider deletes an authentication provider
+func (c *Client) DeleteAuthProvider(name string) (err error) {
+	return c.DeleteAuthProviderContext(context.Background(), name)
+}
+
+// DeleteAuthProviderContext is like DeleteAuthProvider but carries ctx down to the HTTP requests
+func (c *Client) DeleteAuthProviderContext(ctx context.Context, name string) (err error) {
+	return c.deleteSecurityConfig(ctx, fmt.Sprintf("/security/authproviders/%s", name))
+}
+
+// UpdateAuthProviderOrder sets the order in which the authentication
+// providers are tried, the providers left out being disabled
+func (c *Client) UpdateAuthProviderOrder(names []string) (err error) {
+	return c.UpdateAuthProviderOrderContext(context.Background(), names)
+}
+
+// UpdateAuthProviderOrderContext is like UpdateAuthProviderOrder but carries ctx down to the HTTP requests
+func (c *Client) UpdateAuthProviderOrderContext(ctx context.Context, names []string) (err error) {
+	return c.orderSecurityConfigs(ctx, "/security/authproviders", names)
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const ldapAuthProviderXML = `<org.geoserver.security.ldap.LDAPSecurityServiceConfig>
+<id>-6b1b4a4a:18b</id>
+<name>ldap</name>
+<className>org.geoserver.security.ldap.LDAPAuthenticationProvider</className>
+<userGroupServiceName>default</userGroupServiceName>
+<serverURL>ldap://ldap.example.com:389/dc=example,dc=com</serverURL>
+<useTLS>true</useTLS>
+<userDnPattern>uid={0},ou=people</userDnPattern>
+<groupSearchBase>ou=groups</groupSearchBase>
+<groupSearchFilter>member={0}</groupSearchFilter>
+<useNestedParentGroups>false</useNestedParentGroups>
+</org.geoserver.security.ldap.LDAPSecurityServiceConfig>`
+
+func TestGetAuthProviderSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/authproviders/ldap")
+
+		w.WriteHeader(200)
+		w.Write([]byte(ldapAuthProviderXML))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	provider, err := cli.GetAuthProvider("ldap")
+
+	assert.Nil(t, err)
+	assert.Equal(t, AuthProviderConfigLDAP, provider.ConfigClass)
+	assert.Equal(t, "ldap", provider.Name)
+	assert.Equal(t, AuthProviderClassLDAP, provider.ClassName)
+	assert.Equal(t, "ldap://ldap.example.com:389/dc=example,dc=com", provider.ServerURL)
+	assert.True(t, provider.UseTLS)
+	assert.Equal(t, "uid={0},ou=people", provider.UserDnPattern)
+	assert.Equal(t, []*SecurityConfigProperty{
+		{XMLName: xml.Name{Local: "useNestedParentGroups"}, Value: "false"},
+	}, provider.Properties)
+}
+
+func TestGetAuthProviderNotFound(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	provider, err := cli.GetAuthProvider("unknown")
+
+	assert.Nil(t, provider)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestCreateAuthProviderSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/security/authproviders")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<org.geoserver.security.config.UsernamePasswordAuthenticationProviderConfig><name>internal</name><className>org.geoserver.security.auth.UsernamePasswordAuthenticationProvider</className><userGroupServiceName>default</userGroupServiceName></org.geoserver.security.config.UsernamePasswordAuthenticationProviderConfig>`, string(rawBody))
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateAuthProvider(&AuthProvider{
+		ConfigClass:          AuthProviderConfigUsernamePassword,
+		Name:                 "internal",
+		ClassName:            AuthProviderClassUsernamePassword,
+		UserGroupServiceName: "default",
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestUpdateAuthProviderKeepsProperties(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/security/authproviders/ldap")
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(200)
+			w.Write([]byte(ldapAuthProviderXML))
+		case "PUT":
+			rawBody, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.Contains(t, string(rawBody), `<org.geoserver.security.ldap.LDAPSecurityServiceConfig><id>-6b1b4a4a:18b</id>`)
+			assert.NotContains(t, string(rawBody), `<useTLS>`)
+			assert.Contains(t, string(rawBody), `<useNestedParentGroups>false</useNestedParentGroups>`)
+
+			w.WriteHeader(200)
+		}
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	provider, err := cli.GetAuthProvider("ldap")
+	assert.Nil(t, err)
+
+	provider.UseTLS = false
+	err = cli.UpdateAuthProvider("ldap", provider)
+
+	assert.Nil(t, err)
+}
+
+func TestAuthProviderRequests(t *testing.T) {
+	var calls []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<authProviders><authProvider><name>default</name></authProvider></authProviders>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	names, err := cli.GetAuthProviderNames()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"default"}, names)
+	assert.Nil(t, cli.UpdateAuthProviderOrder([]string{"ldap", "default"}))
+	assert.Nil(t, cli.DeleteAuthProvider("ldap"))
+	assert.Equal(t, []string{
+		"GET /security/authproviders",
+		"PUT /security/authproviders/order",
+		"DELETE /security/authproviders/ldap",
+	}, calls)
+}
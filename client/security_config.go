@@ -0,0 +1,154 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+)
+
+// SecurityConfigProperty is a property of a security configuration that has
+// no dedicated field, kept so that it is sent back on update
+type SecurityConfigProperty struct {
+	XMLName xml.Name
+	Value   string `xml:",innerxml"`
+}
+
+// securityConfigList is a list of named security configurations, whatever
+// the name of its elements
+type securityConfigList struct {
+	List []struct {
+		Name string `xml:"name"`
+	} `xml:",any"`
+}
+
+// securityOrder is the order of named security configurations
+type securityOrder struct {
+	XMLName xml.Name `xml:"order"`
+	List    []string `xml:"order"`
+}
+
+// The security configurations are sent as the XML GeoServer persists them
+// in its data directory, where the root element is the class of the
+// configuration. The helpers below share the requests managing them.
+
+// getSecurityConfigNames returns the names of the configurations listed at
+// endpoint
+func (c *Client) getSecurityConfigNames(ctx context.Context, endpoint string) (names []string, err error) {
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data securityConfigList
+	if err = xml.Unmarshal([]byte(body), &data); err != nil {
+		return
+	}
+
+	for _, config := range data.List {
+		names = append(names, config.Name)
+	}
+
+	return
+}
+
+// getSecurityConfig reads the configuration at endpoint into data
+func (c *Client) getSecurityConfig(ctx context.Context, endpoint string, data any) (err error) {
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	return xml.Unmarshal([]byte(body), data)
+}
+
+// writeSecurityConfig creates (POST) or updates (PUT) a configuration
+func (c *Client) writeSecurityConfig(ctx context.Context, method, endpoint string, data any) (err error) {
+	payload, err := xml.Marshal(data)
+	if err != nil {
+		return
+	}
+
+	statusCode, body, err := c.doRequest(ctx, method, endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError(method, endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError(method, endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError(method, endpoint, statusCode, body, ErrForbidden)
+		return
+	case 409:
+		err = newAPIError(method, endpoint, statusCode, body, ErrConflict)
+		return
+	case 200, 201:
+		return
+	default:
+		err = newAPIError(method, endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// deleteSecurityConfig deletes the configuration at endpoint
+func (c *Client) deleteSecurityConfig(ctx context.Context, endpoint string) (err error) {
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 403:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
+		return
+	case 404:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// orderSecurityConfigs sets the order of the configurations listed at
+// endpoint
+func (c *Client) orderSecurityConfigs(ctx context.Context, endpoint string, names []string) (err error) {
+	return c.writeSecurityConfig(ctx, "PUT", endpoint+"/order", securityOrder{List: names})
+}
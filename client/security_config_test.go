@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSecurityConfigNamesSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/authproviders")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<authProviders><authProvider><name>default</name></authProvider><authProvider><name>ldap</name></authProvider></authProviders>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	names, err := cli.getSecurityConfigNames(context.Background(), "/security/authproviders")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"default", "ldap"}, names)
+}
+
+func TestOrderSecurityConfigs(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/security/authproviders/order")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<order><order>ldap</order><order>default</order></order>`, string(rawBody))
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.orderSecurityConfigs(context.Background(), "/security/authproviders", []string{"ldap", "default"})
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteSecurityConfigInUse(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(403)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.deleteSecurityConfig(context.Background(), "/security/authfilters/basic")
+
+	assert.True(t, errors.Is(err, ErrNotEmpty))
+}
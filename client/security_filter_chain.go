@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+)
+
+// Classes of the filter chains shipped with GeoServer
+const (
+	FilterChainClassHtmlLogin = "org.geoserver.security.HtmlLoginFilterChain"
+	FilterChainClassService   = "org.geoserver.security.ServiceLoginFilterChain"
+	FilterChainClassConstant  = "org.geoserver.security.ConstantFilterChain"
+	FilterChainClassLogout    = "org.geoserver.security.LogoutFilterChain"
+)
+
+// FilterChains is the list of the filter chains, in the order they are
+// matched against the requests
+type FilterChains struct {
+	XMLName xml.Name       `xml:"filterChain"`
+	List    []*FilterChain `xml:"filters"`
+}
+
+// FilterChain is the chain of filters applied to the requests matching its
+// path patterns
+type FilterChain struct {
+	XMLName xml.Name `xml:"filters"`
+	Name    string   `xml:"name,attr"`
+	Class   string   `xml:"class,attr"`
+	// Path is the comma-separated list of the Ant patterns of the chain,
+	// e.g. "/rest.*,/rest/**"
+	Path                     string   `xml:"path,attr"`
+	IsDisabled               bool     `xml:"disabled,attr"`
+	AllowSessionCreation     bool     `xml:"allowSessionCreation,attr"`
+	RequireSSL               bool     `xml:"ssl,attr"`
+	MatchHTTPMethod          bool     `xml:"matchHTTPMethod,attr"`
+	HTTPMethods              string   `xml:"httpMethods,attr,omitempty"`
+	InterceptorName          string   `xml:"interceptorName,attr,omitempty"`
+	ExceptionTranslationName string   `xml:"exceptionTranslationName,attr,omitempty"`
+	RoleFilterName           string   `xml:"roleFilterName,attr,omitempty"`
+	Filters                  []string `xml:"filter"`
+}
+
+// GetFilterChains returns the filter chains, in the order they are matched
+func (c *Client) GetFilterChains() (chains []*FilterChain, err error) {
+	return c.GetFilterChainsContext(context.Background())
+}
+
+// GetFilterChainsContext is like GetFilterChains but carries ctx down to the HTTP requests
+func (c *Client) GetFilterChainsContext(ctx context.Context) (chains []*FilterChain, err error) {
+	var data FilterChains
+	if err = c.getSecurityConfig(ctx, "/security/filterChain", &data); err != nil {
+		return
+	}
+
+	return data.List, nil
+}
+
+// GetFilterChain returns a filter chain based on its name
+func (c *Client) GetFilterChain(name string) (chain *FilterChain, err error) {
+	return c.GetFilterChainContext(context.Background(), name)
+}
+
+// GetFilterChainContext is like GetFilterChain but carries ctx down to the HTTP requests
+func (c *Client) GetFilterChainContext(ctx context.Context, name string) (chain *FilterChain, err error) {
+	var data FilterChain
+	if err = c.getSecurityConfig(ctx, fmt.Sprintf("/security/filterChain/%s", name), &data); err != nil {
+		return
+	}
+
+	chain = &data
+
+	return
+}
+
+// CreateFilterChain creates a filter chain
+func (c *Client) CreateFilterChain(chain *FilterChain) (err error) {
+	return c.CreateFilterChainContext(context.Background(), chain)
+}
+
+// CreateFilterChainContext is like CreateFilterChain but carries ctx down to the HTTP requests
+func (c *Client) CreateFilterChainContext(ctx context.Context, chain *FilterChain) (err error) {
+	chain.XMLName = xml.Name{
+		Local: "filters",
+	}
+
+	return c.writeSecurityConfig(ctx, "POST", "/security/filterChain", chain)
+}
+
+// UpdateFilterChain updates a filter chain
+func (c *Client) UpdateFilterChain(name string, chain *FilterChain) (err error) {
+	return c.UpdateFilterChainContext(context.Background(), name, chain)
+}
+
+// UpdateFilterChainContext is like UpdateFilterChain but carries ctx down to the HTTP requests
+func (c *Client) UpdateFilterChainContext(ctx context.Context, name string, chain *FilterChain) (err error) {
+	chain.XMLName = xml.Name{
+		Local: "filters",
+	}
+
+	return c.writeSecurityConfig(ctx, "PUT", fmt.Sprintf("/security/filterChain/%s", name), chain)
+}
+
+// DeleteFilterChain deletes a filter chain
+func (c *Client) DeleteFilterChain(name string) (err error) {
+	return c.DeleteFilterChainContext(context.Background(), name)
+}
+
+// DeleteFilterChainContext is like DeleteFilterChain but carries ctx down to the HTTP requests
+func (c *Client) DeleteFilterChainContext(ctx context.Context, name string) (err error) {
+	return c.deleteSecurityConfig(ctx, fmt.Sprintf("/security/filterChain/%s", name))
+}
+
+// UpdateFilterChainOrder sets the order in which the filter chains are
+// matched against the requests, the first matching one applying
+func (c *Client) UpdateFilterChainOrder(names []string) (err error) {
+	return c.UpdateFilterChainOrderContext(context.Background(), names)
+}
+
+// UpdateFilterChainOrderContext is like UpdateFilterChainOrder but carries ctx down to the HTTP requests
+func (c *Client) UpdateFilterChainOrderContext(ctx context.Context, names []string) (err error) {
+	return c.orderSecurityConfigs(ctx, "/security/filterChain", names)
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFilterChainsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/filterChain")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<filterChain>
+<filters name="web" class="org.geoserver.security.HtmlLoginFilterChain" interceptorName="interceptor" exceptionTranslationName="exception" path="/web/**,/gwc/rest/web/**,/" disabled="false" allowSessionCreation="true" ssl="false" matchHTTPMethod="false">
+<filter>rememberme</filter>
+<filter>form</filter>
+<filter>anonymous</filter>
+</filters>
+<filters name="default" class="org.geoserver.security.ServiceLoginFilterChain" interceptorName="interceptor" exceptionTranslationName="exception" path="/**" disabled="false" allowSessionCreation="false" ssl="false" matchHTTPMethod="false">
+<filter>basic</filter>
+<filter>anonymous</filter>
+</filters>
+</filterChain>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	chains, err := cli.GetFilterChains()
+
+	assert.Nil(t, err)
+	assert.Len(t, chains, 2)
+	assert.Equal(t, "web", chains[0].Name)
+	assert.Equal(t, FilterChainClassHtmlLogin, chains[0].Class)
+	assert.Equal(t, "/web/**,/gwc/rest/web/**,/", chains[0].Path)
+	assert.True(t, chains[0].AllowSessionCreation)
+	assert.Equal(t, []string{"rememberme", "form", "anonymous"}, chains[0].Filters)
+	assert.Equal(t, "default", chains[1].Name)
+	assert.Equal(t, []string{"basic", "anonymous"}, chains[1].Filters)
+}
+
+func TestCreateFilterChainSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/security/filterChain")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<filters name="api" class="org.geoserver.security.ServiceLoginFilterChain" path="/api/**" disabled="false" allowSessionCreation="false" ssl="true" matchHTTPMethod="true" httpMethods="GET,POST" interceptorName="restInterceptor" exceptionTranslationName="exception"><filter>basic</filter></filters>`, string(rawBody))
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateFilterChain(&FilterChain{
+		Name:                     "api",
+		Class:                    FilterChainClassService,
+		Path:                     "/api/**",
+		RequireSSL:               true,
+		MatchHTTPMethod:          true,
+		HTTPMethods:              "GET,POST",
+		InterceptorName:          "restInterceptor",
+		ExceptionTranslationName: "exception",
+		Filters:                  []string{"basic"},
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestFilterChainRequests(t *testing.T) {
+	var calls []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<filters name="api" class="org.geoserver.security.ServiceLoginFilterChain" path="/api/**"><filter>basic</filter></filters>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	chain, err := cli.GetFilterChain("api")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"basic"}, chain.Filters)
+
+	chain.Filters = append(chain.Filters, "anonymous")
+	assert.Nil(t, cli.UpdateFilterChain("api", chain))
+	assert.Nil(t, cli.UpdateFilterChainOrder([]string{"api", "web", "default"}))
+	assert.Nil(t, cli.DeleteFilterChain("api"))
+	assert.Equal(t, []string{
+		"GET /security/filterChain/api",
+		"PUT /security/filterChain/api",
+		"PUT /security/filterChain/order",
+		"DELETE /security/filterChain/api",
+	}, calls)
+}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+)
+
+// MasterPassword is the master password of the GeoServer keystore. Only the
+// old password is returned when it is read.
+type MasterPassword struct {
+	XMLName     xml.Name `xml:"masterPassword"`
+	OldPassword string   `xml:"oldMasterPassword"`
+	NewPassword string   `xml:"newMasterPassword,omitempty"`
+}
+
+// UserPassword is the new password of the authenticated user
+type UserPassword struct {
+	XMLName     xml.Name `xml:"userPassword"`
+	NewPassword string   `xml:"newPassword"`
+}
+
+// credentialsKey is the context key of the credentials authenticating the
+// requests of a password rotation
+type credentialsKey struct{}
+
+// credentials are a username and its password
+type credentials struct {
+	username string
+	password string
+}
+
+// GetMasterPassword returns the master password
+func (c *Client) GetMasterPassword() (password string, err error) {
+	return c.GetMasterPasswordContext(context.Background())
+}
+
+// GetMasterPasswordContext is like GetMasterPassword but carries ctx down to the HTTP requests
+func (c *Client) GetMasterPasswordContext(ctx context.Context) (password string, err error) {
+	endpoint := "/security/masterpw"
+
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 403:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data MasterPassword
+	if err = xml.Unmarshal([]byte(body), &data); err != nil {
+		return
+	}
+
+	return data.OldPassword, nil
+}
+
+// UpdateMasterPassword changes the master password. ErrPolicyViolation is
+// returned when the old password is wrong or the new one does not comply
+// with the master password policy.
+func (c *Client) UpdateMasterPassword(oldPassword, newPassword string) (err error) {
+	return c.UpdateMasterPasswordContext(context.Background(), oldPassword, newPassword)
+}
+
+// UpdateMasterPasswordContext is like UpdateMasterPassword but carries ctx down to the HTTP requests
+func (c *Client) UpdateMasterPasswordContext(ctx context.Context, oldPassword, newPassword string) (err error) {
+	return c.updatePassword(ctx, "/security/masterpw", MasterPassword{
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	})
+}
+
+// ChangePassword changes the password of the authenticated user.
+// ErrPolicyViolation is returned when the new password does not comply with
+// the password policy of its user/group service.
+func (c *Client) ChangePassword(newPassword string) (err error) {
+	return c.ChangePasswordContext(context.Background(), newPassword)
+}
+
+// ChangePasswordContext is like ChangePassword but carries ctx down to the HTTP requests
+func (c *Client) ChangePasswordContext(ctx context.Context, newPassword string) (err error) {
+	return c.updatePassword(ctx, "/security/self/password", UserPassword{
+		NewPassword: newPassword,
+	})
+}
+
+// updatePassword sends a new password
+func (c *Client) updatePassword(ctx context.Context, endpoint string, data any) (err error) {
+	payload, err := xml.Marshal(data)
+	if err != nil {
+		return
+	}
+
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 403:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 405:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 422:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrPolicyViolation)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// RotatePassword changes the password of the user the client authenticates
+// as, then makes the client use it. The requests sent by other goroutines
+// wait for the rotation to complete, so that none of them is sent with a
+// stale password. It only applies to the basic authentication done with
+// Username and Password: an error is returned when an Authenticator is set,
+// which the client could not update.
+func (c *Client) RotatePassword(newPassword string) (err error) {
+	return c.RotatePasswordContext(context.Background(), newPassword)
+}
+
+// RotatePasswordContext is like RotatePassword but carries ctx down to the HTTP requests
+func (c *Client) RotatePasswordContext(ctx context.Context, newPassword string) (err error) {
+	if c.Authenticator != nil {
+		return errors.New("cannot rotate the password of a client using an Authenticator")
+	}
+
+	c.credentialsMutex.Lock()
+	defer c.credentialsMutex.Unlock()
+
+	// The request changing the password is authenticated with the current
+	// credentials, which cannot be read under the lock
+	ctx = context.WithValue(ctx, credentialsKey{}, credentials{
+		username: c.Username,
+		password: c.Password,
+	})
+	if err = c.ChangePasswordContext(ctx, newPassword); err != nil {
+		return
+	}
+
+	c.Password = newPassword
+
+	return
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+)
+
+// Configuration class and implementation class of the password policies
+const (
+	PasswordPolicyConfig = "org.geoserver.security.config.PasswordPolicyConfig"
+	PasswordPolicyClass  = "org.geoserver.security.validation.PasswordValidatorImpl"
+)
+
+// PasswordPolicy is the configuration of a password policy, enforced by the
+// user/group services using it
+type PasswordPolicy struct {
+	XMLName           xml.Name `xml:"org.geoserver.security.config.PasswordPolicyConfig"`
+	ID                string   `xml:"id,omitempty"`
+	Name              string   `xml:"name"`
+	ClassName         string   `xml:"className"`
+	IsUppercaseNeeded bool     `xml:"uppercaseRequired"`
+	IsLowercaseNeeded bool     `xml:"lowercaseRequired"`
+	IsDigitNeeded     bool     `xml:"digitRequired"`
+	MinimumLength     int      `xml:"minLength"`
+	// MaximumLength is -1 for no limit
+	MaximumLength int `xml:"maxLength"`
+}
+
+// GetPasswordPolicyNames returns the names of the password policies
+func (c *Client) GetPasswordPolicyNames() (names []string, err error) {
+	return c.GetPasswordPolicyNamesContext(context.Background())
+}
+
+// GetPasswordPolicyNamesContext is like GetPasswordPolicyNames but carries ctx down to the HTTP requests
+func (c *Client) GetPasswordPolicyNamesContext(ctx context.Context) (names []string, err error) {
+	return c.getSecurityConfigNames(ctx, "/security/passwordpolicies")
+}
+
+// GetPasswordPolicy returns a password policy based on its name
+func (c *Client) GetPasswordPolicy(name string) (policy *PasswordPolicy, err error) {
+	return c.GetPasswordPolicyContext(context.Background(), name)
+}
+
+// GetPasswordPolicyContext is like GetPasswordPolicy but carries ctx down to the HTTP requests
+func (c *Client) GetPasswordPolicyContext(ctx context.Context, name string) (policy *PasswordPolicy, err error) {
+	var data PasswordPolicy
+	if err = c.getSecurityConfig(ctx, fmt.Sprintf("/security/passwordpolicies/%s", name), &data); err != nil {
+		return
+	}
+
+	policy = &data
+
+	return
+}
+
+// CreatePasswordPolicy creates a password policy
+func (c *Client) CreatePasswordPolicy(policy *PasswordPolicy) (err error) {
+	return c.CreatePasswordPolicyContext(context.Background(), policy)
+}
+
+// CreatePasswordPolicyContext is like CreatePasswordPolicy but carries ctx down to the HTTP requests
+func (c *Client) CreatePasswordPolicyContext(ctx context.Context, policy *PasswordPolicy) (err error) {
+	policy.XMLName = xml.Name{
+		Local: PasswordPolicyConfig,
+	}
+	if policy.ClassName == "" {
+		policy.ClassName = PasswordPolicyClass
+	}
+
+	return c.writeSecurityConfig(ctx, "POST", "/security/passwordpolicies", policy)
+}
+
+// UpdatePasswordPolicy updates a password policy
+func (c *Client) UpdatePasswordPolicy(name string, policy *PasswordPolicy) (err error) {
+	return c.UpdatePasswordPolicyContext(context.Background(), name, policy)
+}
+
+// UpdatePasswordPolicyContext is like UpdatePasswordPolicy but carries ctx down to the HTTP requests
+func (c *Client) UpdatePasswordPolicyContext(ctx context.Context, name string, policy *PasswordPolicy) (err error) {
+	policy.XMLName = xml.Name{
+		Local: PasswordPolicyConfig,
+	}
+	if policy.ClassName == "" {
+		policy.ClassName = PasswordPolicyClass
+	}
+
+	return c.writeSecurityConfig(ctx, "PUT", fmt.Sprintf("/security/passwordpolicies/%s", name), policy)
+}
+
+// DeletePasswordPolicy deletes a password policy, which must not be used by
+// any user/group service
+func (c *Client) DeletePasswordPolicy(name string) (err error) {
+	return c.DeletePasswordPolicyContext(context.Background(), name)
+}
+
+// DeletePasswordPolicyContext is like DeletePasswordPolicy but carries ctx down to the HTTP requests
+func (c *Client) DeletePasswordPolicyContext(ctx context.Context, name string) (err error) {
+	return c.deleteSecurityConfig(ctx, fmt.Sprintf("/security/passwordpolicies/%s", name))
+}
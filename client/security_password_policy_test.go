@@ -0,0 +1,100 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPasswordPolicySuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/passwordpolicies/default")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<org.geoserver.security.config.PasswordPolicyConfig>
+<id>-1fc8d2a0:13a81ff5d31:-8000</id>
+<name>default</name>
+<className>org.geoserver.security.validation.PasswordValidatorImpl</className>
+<uppercaseRequired>false</uppercaseRequired>
+<lowercaseRequired>false</lowercaseRequired>
+<digitRequired>false</digitRequired>
+<minLength>0</minLength>
+<maxLength>-1</maxLength>
+</org.geoserver.security.config.PasswordPolicyConfig>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	policy, err := cli.GetPasswordPolicy("default")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "default", policy.Name)
+	assert.Equal(t, PasswordPolicyClass, policy.ClassName)
+	assert.Equal(t, 0, policy.MinimumLength)
+	assert.Equal(t, -1, policy.MaximumLength)
+}
+
+func TestCreatePasswordPolicySuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/security/passwordpolicies")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<org.geoserver.security.config.PasswordPolicyConfig><name>strong</name><className>org.geoserver.security.validation.PasswordValidatorImpl</className><uppercaseRequired>true</uppercaseRequired><lowercaseRequired>true</lowercaseRequired><digitRequired>true</digitRequired><minLength>12</minLength><maxLength>-1</maxLength></org.geoserver.security.config.PasswordPolicyConfig>`, string(rawBody))
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreatePasswordPolicy(&PasswordPolicy{
+		Name:              "strong",
+		IsUppercaseNeeded: true,
+		IsLowercaseNeeded: true,
+		IsDigitNeeded:     true,
+		MinimumLength:     12,
+		MaximumLength:     -1,
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestPasswordPolicyRequests(t *testing.T) {
+	var calls []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<passwordPolicies><passwordPolicy><name>default</name></passwordPolicy><passwordPolicy><name>master</name></passwordPolicy></passwordPolicies>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	names, err := cli.GetPasswordPolicyNames()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"default", "master"}, names)
+	assert.Nil(t, cli.UpdatePasswordPolicy("strong", &PasswordPolicy{Name: "strong", MinimumLength: 16}))
+	assert.Nil(t, cli.DeletePasswordPolicy("strong"))
+	assert.Equal(t, []string{
+		"GET /security/passwordpolicies",
+		"PUT /security/passwordpolicies/strong",
+		"DELETE /security/passwordpolicies/strong",
+	}, calls)
+}
@@ -0,0 +1,199 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMasterPasswordSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/masterpw")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<masterPassword><oldMasterPassword>geoserver</oldMasterPassword></masterPassword>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	password, err := cli.GetMasterPassword()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "geoserver", password)
+}
+
+func TestUpdateMasterPasswordSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/security/masterpw")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<masterPassword><oldMasterPassword>geoserver</oldMasterPassword><newMasterPassword>s3cr3t!geoserver</newMasterPassword></masterPassword>`, string(rawBody))
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateMasterPassword("geoserver", "s3cr3t!geoserver")
+
+	assert.Nil(t, err)
+}
+
+func TestUpdateMasterPasswordPolicyViolation(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(422)
+		w.Write([]byte(`Password must have at least 8 characters`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateMasterPassword("geoserver", "short")
+
+	assert.True(t, errors.Is(err, ErrPolicyViolation))
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "Password must have at least 8 characters", apiErr.Body)
+}
+
+func TestChangePasswordSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/security/self/password")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<userPassword><newPassword>n3w</newPassword></userPassword>`, string(rawBody))
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.ChangePassword("n3w")
+
+	assert.Nil(t, err)
+}
+
+func TestRotatePassword(t *testing.T) {
+	var mutex sync.Mutex
+	current := "old"
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if _, password, _ := r.BasicAuth(); password != current {
+			w.WriteHeader(401)
+			return
+		}
+
+		if r.URL.Path == "/security/self/password" {
+			rawBody, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			if string(rawBody) == `<userPassword><newPassword>weak</newPassword></userPassword>` {
+				w.WriteHeader(422)
+				return
+			}
+			current = "new"
+		}
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<workspaces/>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		Username:   "admin",
+		Password:   "old",
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.RotatePassword("weak")
+	assert.True(t, errors.Is(err, ErrPolicyViolation))
+	assert.Equal(t, "old", cli.Password)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := cli.GetWorkspaces()
+			errs <- err
+		}()
+	}
+
+	err = cli.RotatePassword("new")
+	wg.Wait()
+	close(errs)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "new", cli.Password)
+	for err := range errs {
+		assert.Nil(t, err)
+	}
+}
+
+func TestRotatePasswordUnauthorized(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(401)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		Username:   "admin",
+		Password:   "wrong",
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.RotatePassword("new")
+
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+	assert.Equal(t, "wrong", cli.Password)
+}
+
+func TestRotatePasswordWithAuthenticator(t *testing.T) {
+	called := false
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:           testServer.URL,
+		Authenticator: &BasicAuth{Username: "admin", Password: "old"},
+		HTTPClient:    &http.Client{},
+	}
+
+	err := cli.RotatePassword("new")
+
+	assert.NotNil(t, err)
+	assert.False(t, called)
+}
@@ -0,0 +1,331 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// Roles is a list of role names
+type Roles struct {
+	XMLName xml.Name `xml:"roles"`
+	List    []string `xml:"role"`
+}
+
+// rolesEndpoint returns the endpoint of path within a role service, the
+// active one when service is empty
+func rolesEndpoint(service, path string) string {
+	if service == "" {
+		return "/security/roles" + path
+	}
+
+	return fmt.Sprintf("/security/roles/service/%s%s", service, path)
+}
+
+// GetRoles returns the names of the roles of a role service
+func (c *Client) GetRoles(service string) (roles []string, err error) {
+	return c.GetRolesContext(context.Background(), service)
+}
+
+// GetRolesContext is like GetRoles but carries ctx down to the HTTP requests
+func (c *Client) GetRolesContext(ctx context.Context, service string) (roles []string, err error) {
+	return c.getRoles(ctx, rolesEndpoint(service, ""))
+}
+
+// GetUserRoles returns the names of the roles assigned to a user
+func (c *Client) GetUserRoles(service, userName string) (roles []string, err error) {
+	return c.GetUserRolesContext(context.Background(), service, userName)
+}
+
+// GetUserRolesContext is like GetUserRoles but carries ctx down to the HTTP requests
+func (c *Client) GetUserRolesContext(ctx context.Context, service, userName string) (roles []string, err error) {
+	return c.getRoles(ctx, rolesEndpoint(service, fmt.Sprintf("/user/%s", userName)))
+}
+
+// GetGroupRoles returns the names of the roles assigned to a user group
+func (c *Client) GetGroupRoles(service, groupName string) (roles []string, err error) {
+	return c.GetGroupRolesContext(context.Background(), service, groupName)
+}
+
+// GetGroupRolesContext is like GetGroupRoles but carries ctx down to the HTTP requests
+func (c *Client) GetGroupRolesContext(ctx context.Context, service, groupName string) (roles []string, err error) {
+	return c.getRoles(ctx, rolesEndpoint(service, fmt.Sprintf("/group/%s", groupName)))
+}
+
+// getRoles reads a list of roles
+func (c *Client) getRoles(ctx context.Context, endpoint string) (roles []string, err error) {
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data Roles
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return roles, err
+	}
+
+	return data.List, nil
+}
+
+// CreateRole creates a role
+func (c *Client) CreateRole(service, role string) (err error) {
+	return c.CreateRoleContext(context.Background(), service, role)
+}
+
+// CreateRoleContext is like CreateRole but carries ctx down to the HTTP requests
+func (c *Client) CreateRoleContext(ctx context.Context, service, role string) (err error) {
+	return c.roleRequest(ctx, "POST", rolesEndpoint(service, fmt.Sprintf("/role/%s", role)))
+}
+
+// DeleteRole deletes a role
+func (c *Client) DeleteRole(service, role string) (err error) {
+	return c.DeleteRoleContext(context.Background(), service, role)
+}
+
+// DeleteRoleContext is like DeleteRole but carries ctx down to the HTTP requests
+func (c *Client) DeleteRoleContext(ctx context.Context, service, role string) (err error) {
+	return c.roleRequest(ctx, "DELETE", rolesEndpoint(service, fmt.Sprintf("/role/%s", role)))
+}
+
+// AssignRoleToUser assigns a role to a user
+func (c *Client) AssignRoleToUser(service, role, userName string) (err error) {
+	return c.AssignRoleToUserContext(context.Background(), service, role, userName)
+}
+
+// AssignRoleToUserContext is like AssignRoleToUser but carries ctx down to the HTTP requests
+func (c *Client) AssignRoleToUserContext(ctx context.Context, service, role, userName string) (err error) {
+	return c.roleRequest(ctx, "POST", rolesEndpoint(service, fmt.Sprintf("/role/%s/user/%s", role, userName)))
+}
+
+// UnassignRoleFromUser removes a role from a user
+func (c *Client) UnassignRoleFromUser(service, role, userName string) (err error) {
+	return c.UnassignRoleFromUserContext(context.Background(), service, role, userName)
+}
+
+// UnassignRoleFromUserContext is like UnassignRoleFromUser but carries ctx down to the HTTP requests
+func (c *Client) UnassignRoleFromUserContext(ctx context.Context, service, role, userName string) (err error) {
+	return c.roleRequest(ctx, "DELETE", rolesEndpoint(service, fmt.Sprintf("/role/%s/user/%s", role, userName)))
+}
+
+// AssignRoleToGroup assigns a role to a user group
+func (c *Client) AssignRoleToGroup(service, role, groupName string) (err error) {
+	return c.AssignRoleToGroupContext(context.Background(), service, role, groupName)
+}
+
+// AssignRoleToGroupContext is like AssignRoleToGroup but carries ctx down to the HTTP requests
+func (c *Client) AssignRoleToGroupContext(ctx context.Context, service, role, groupName string) (err error) {
+	return c.roleRequest(ctx, "POST", rolesEndpoint(service, fmt.Sprintf("/role/%s/group/%s", role, groupName)))
+}
+
+// UnassignRoleFromGroup removes a role from a user group
+func (c *Client) UnassignRoleFromGroup(service, role, groupName string) (err error) {
+	return c.UnassignRoleFromGroupContext(context.Background(), service, role, groupName)
+}
+
+// UnassignRoleFromGroupContext is like UnassignRoleFromGroup but carries ctx down to the HTTP requests
+func (c *Client) UnassignRoleFromGroupContext(ctx context.Context, service, role, groupName string) (err error) {
+	return c.roleRequest(ctx, "DELETE", rolesEndpoint(service, fmt.Sprintf("/role/%s/group/%s", role, groupName)))
+}
+
+// roleRequest sends a request without body nor response to the roles API
+func (c *Client) roleRequest(ctx context.Context, method, endpoint string) (err error) {
+	statusCode, body, err := c.doRequest(ctx, method, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError(method, endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError(method, endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError(method, endpoint, statusCode, body, ErrForbidden)
+		return
+	case 409:
+		err = newAPIError(method, endpoint, statusCode, body, ErrConflict)
+		return
+	case 200, 201:
+		return
+	default:
+		err = newAPIError(method, endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// The REST API does not expose the role hierarchy, which is therefore read
+// from and written to the roles file of XML role services through the
+// resource API. GeoServer watches this file and reloads the service when it
+// changes.
+
+// roleRegistry is the content of the roles file of an XML role service
+type roleRegistry struct {
+	XMLName xml.Name `xml:"http://www.geoserver.org/security/roles roleRegistry"`
+	Version string   `xml:"version,attr,omitempty"`
+	Roles   struct {
+		List []*registryRole `xml:"role"`
+	} `xml:"roleList"`
+	Users struct {
+		List []*registryRoleRefs `xml:"userRoles"`
+	} `xml:"userList"`
+	Groups struct {
+		List []*registryRoleRefs `xml:"groupRoles"`
+	} `xml:"groupList"`
+}
+
+type registryRole struct {
+	ID         string                  `xml:"id,attr"`
+	ParentID   string                  `xml:"parentID,attr,omitempty"`
+	Properties []*registryRoleProperty `xml:"property"`
+}
+
+type registryRoleProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+// registryRoleRefs are the roles of a user, or of a group
+type registryRoleRefs struct {
+	UserName  string `xml:"username,attr,omitempty"`
+	GroupName string `xml:"groupname,attr,omitempty"`
+	Refs      []struct {
+		RoleID string `xml:"roleID,attr"`
+	} `xml:"roleRef"`
+}
+
+// roleServiceResource returns the path of the roles file of an XML role
+// service, without its extension. Like for rolesEndpoint, the active service
+// is used when service is empty. Other services have no such file and are
+// rejected.
+func (c *Client) roleServiceResource(ctx context.Context, service string) (resource string, err error) {
+	if service == "" {
+		if service, err = c.GetActiveRoleServiceContext(ctx); err != nil {
+			return
+		}
+	}
+
+	config, err := c.GetRoleServiceContext(ctx, service)
+	if err != nil {
+		return
+	}
+	if config.ConfigClass != RoleServiceConfigXML {
+		return resource, fmt.Errorf("role service %s is not an XML role service, its role hierarchy cannot be edited", service)
+	}
+
+	fileName := "roles"
+	if config.FileName != "" {
+		fileName = strings.TrimSuffix(config.FileName, ".xml")
+	}
+
+	return fmt.Sprintf("security/role/%s/%s", service, fileName), nil
+}
+
+// getRoleRegistry reads the roles file of an XML role service
+func (c *Client) getRoleRegistry(ctx context.Context, service string) (resource string, registry *roleRegistry, err error) {
+	if resource, err = c.roleServiceResource(ctx, service); err != nil {
+		return
+	}
+
+	content, err := c.GetResourceContext(ctx, resource, "xml")
+	if err != nil {
+		return
+	}
+
+	var data roleRegistry
+	if err = xml.Unmarshal([]byte(content), &data); err != nil {
+		return
+	}
+
+	return resource, &data, nil
+}
+
+// GetRoleParents returns the parent of each role of an XML role service
+// having one, keyed by role. The active service is used when service is
+// empty.
+func (c *Client) GetRoleParents(service string) (parents map[string]string, err error) {
+	return c.GetRoleParentsContext(context.Background(), service)
+}
+
+// GetRoleParentsContext is like GetRoleParents but carries ctx down to the HTTP requests
+func (c *Client) GetRoleParentsContext(ctx context.Context, service string) (parents map[string]string, err error) {
+	_, registry, err := c.getRoleRegistry(ctx, service)
+	if err != nil {
+		return
+	}
+
+	parents = map[string]string{}
+	for _, role := range registry.Roles.List {
+		if role.ParentID != "" {
+			parents[role.ID] = role.ParentID
+		}
+	}
+
+	return
+}
+
+// SetRoleParent sets the parent of a role of an XML role service, from which
+// the role inherits. An empty parent makes the role a root role. The active
+// service is used when service is empty.
+//
+// The roles file is read, then written back whole: the update is not atomic,
+// and a change made to the roles of the service in between, e.g. through the
+// REST API, is lost.
+func (c *Client) SetRoleParent(service, role, parent string) (err error) {
+	return c.SetRoleParentContext(context.Background(), service, role, parent)
+}
+
+// SetRoleParentContext is like SetRoleParent but carries ctx down to the HTTP requests
+func (c *Client) SetRoleParentContext(ctx context.Context, service, role, parent string) (err error) {
+	resource, registry, err := c.getRoleRegistry(ctx, service)
+	if err != nil {
+		return
+	}
+
+	parents := map[string]string{}
+	for _, r := range registry.Roles.List {
+		parents[r.ID] = r.ParentID
+	}
+	if _, ok := parents[role]; !ok {
+		return fmt.Errorf("role %s: %w", role, ErrNotFound)
+	}
+	if parent != "" {
+		if _, ok := parents[parent]; !ok {
+			return fmt.Errorf("role %s: %w", parent, ErrNotFound)
+		}
+	}
+	for ancestor := parent; ancestor != ""; ancestor = parents[ancestor] {
+		if ancestor == role {
+			return fmt.Errorf("role %s cannot inherit from its descendant %s", role, parent)
+		}
+	}
+
+	for _, r := range registry.Roles.List {
+		if r.ID == role {
+			r.ParentID = parent
+		}
+	}
+
+	payload, err := xml.MarshalIndent(registry, "", "  ")
+	if err != nil {
+		return
+	}
+
+	return c.UpdateResourceContext(ctx, resource, "xml", xml.Header+string(payload))
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+)
+
+// Configuration classes and implementation classes of the role services
+// shipped with GeoServer
+const (
+	RoleServiceConfigXML  = "org.geoserver.security.xml.XMLRoleServiceConfig"
+	RoleServiceClassXML   = "org.geoserver.security.xml.XMLRoleService"
+	RoleServiceConfigJDBC = "org.geoserver.security.jdbc.config.JDBCRoleServiceConfig"
+	RoleServiceClassJDBC  = "org.geoserver.security.jdbc.JDBCRoleService"
+	RoleServiceConfigLDAP = "org.geoserver.security.ldap.LDAPRoleServiceConfig"
+	RoleServiceClassLDAP  = "org.geoserver.security.ldap.LDAPRoleService"
+)
+
+// RoleService is the configuration of a role service
+type RoleService struct {
+	// ConfigClass is the class of the configuration, e.g.
+	// RoleServiceConfigLDAP, used as the root element of the XML
+	ConfigClass        string `xml:"-"`
+	ID                 string `xml:"id,omitempty"`
+	Name               string `xml:"name"`
+	ClassName          string `xml:"className"`
+	AdminRoleName      string `xml:"adminRoleName,omitempty"`
+	GroupAdminRoleName string `xml:"groupAdminRoleName,omitempty"`
+	// XML
+	FileName      string `xml:"fileName,omitempty"`
+	CheckInterval int    `xml:"checkInterval,omitempty"`
+	IsValidating  bool   `xml:"validating,omitempty"`
+	// JDBC
+	PropertyFileNameDDL string `xml:"propertyFileNameDDL,omitempty"`
+	PropertyFileNameDML string `xml:"propertyFileNameDML,omitempty"`
+	IsCreatingTables    bool   `xml:"creatingTables,omitempty"`
+	IsJNDI              bool   `xml:"jndi,omitempty"`
+	JNDIName            string `xml:"jndiName,omitempty"`
+	DriverClassName     string `xml:"driverClassName,omitempty"`
+	ConnectURL          string `xml:"connectURL,omitempty"`
+	// UserName and Password are the credentials of the JDBC connection
+	UserName string `xml:"userName,omitempty"`
+	Password string `xml:"password,omitempty"`
+	// LDAP
+	ServerURL             string `xml:"serverURL,omitempty"`
+	UseTLS                bool   `xml:"useTLS,omitempty"`
+	GroupSearchBase       string `xml:"groupSearchBase,omitempty"`
+	GroupNameAttribute    string `xml:"groupNameAttribute,omitempty"`
+	GroupSearchFilter     string `xml:"groupSearchFilter,omitempty"`
+	AllGroupsSearchFilter string `xml:"allGroupsSearchFilter,omitempty"`
+	UserSearchBase        string `xml:"userSearchBase,omitempty"`
+	UserFilter            string `xml:"userFilter,omitempty"`
+	BindBeforeGroupSearch bool   `xml:"bindBeforeGroupSearch,omitempty"`
+	// User is the DN binding to the LDAP server, with Password
+	User string `xml:"user,omitempty"`
+	// Properties are the properties of other services
+	Properties []*SecurityConfigProperty `xml:",any"`
+}
+
+// activeRoleService is the name of the role service in use
+type activeRoleService struct {
+	XMLName xml.Name `xml:"activeRoleService"`
+	Name    string   `xml:"name"`
+}
+
+// MarshalXML writes the service with its configuration class as root
+// element
+func (s *RoleService) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain RoleService
+	start.Name = xml.Name{Local: s.ConfigClass}
+	return e.EncodeElement((*plain)(s), start)
+}
+
+// UnmarshalXML reads the service, keeping its configuration class
+func (s *RoleService) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain RoleService
+	if err := d.DecodeElement((*plain)(s), &start); err != nil {
+		return err
+	}
+	s.ConfigClass = start.Name.Local
+	return nil
+}
+
+// GetRoleServiceNames returns the names of the role services
+func (c *Client) GetRoleServiceNames() (names []string, err error) {
+	return c.GetRoleServiceNamesContext(context.Background())
+}
+
+// GetRoleServiceNamesContext is like GetRoleServiceNames but carries ctx down to the HTTP requests
+func (c *Client) GetRoleServiceNamesContext(ctx context.Context) (names []string, err error) {
+	return c.getSecurityConfigNames(ctx, "/security/roleservices")
+}
+
+// GetRoleService returns a role service based on its name
+func (c *Client) GetRoleService(name string) (service *RoleService, err error) {
+	return c.GetRoleServiceContext(context.Background(), name)
+}
+
+// GetRoleServiceContext is like GetRoleService but carries ctx down to the HTTP requests
+func (c *Client) GetRoleServiceContext(ctx context.Context, name string) (service *RoleService, err error) {
+	var data RoleService
+	if err = c.getSecurityConfig(ctx, fmt.Sprintf("/security/roleservices/%s", name), &data); err != nil {
+		return
+	}
+
+	service = &data
+
+	return
+}
+
+// CreateRoleService creates a role service
+func (c *Client) CreateRoleService(service *RoleService) (err error) {
+	return c.CreateRoleServiceContext(context.Background(), service)
+}
+
+// CreateRoleServiceContext is like CreateRoleService but carries ctx down to the HTTP requests
+func (c *Client) CreateRoleServiceContext(ctx context.Context, service *RoleService) (err error) {
+	return c.writeSecurityConfig(ctx, "POST", "/security/roleservices", service)
+}
+
+// UpdateRoleService updates a role service
+func (c *Client) UpdateRoleService(name string, service *RoleService) (err error) {
+	return c.UpdateRoleServiceContext(context.Background(), name, service)
+}
+
+// UpdateRoleServiceContext is like UpdateRoleService but carries ctx down to the HTTP requests
+func (c *Client) UpdateRoleServiceContext(ctx context.Context, name string, service *RoleService) (err error) {
+	return c.writeSecurityConfig(ctx, "PUT", fmt.Sprintf("/security/roleservices/%s", name), service)
+}
+
+// DeleteRoleService deletes a role service, which cannot be the active one
+func (c *Client) DeleteRoleService(name string) (err error) {
+	return c.DeleteRoleServiceContext(context.Background(), name)
+}
+
+// DeleteRoleServiceContext is like DeleteRoleService but carries ctx down to the HTTP requests
+func (c *Client) DeleteRoleServiceContext(ctx context.Context, name string) (err error) {
+	return c.deleteSecurityConfig(ctx, fmt.Sprintf("/security/roleservices/%s", name))
+}
+
+// GetActiveRoleService returns the name of the role service in use
+func (c *Client) GetActiveRoleService() (name string, err error) {
+	return c.GetActiveRoleServiceContext(context.Background())
+}
+
+// GetActiveRoleServiceContext is like GetActiveRoleService but carries ctx down to the HTTP requests
+func (c *Client) GetActiveRoleServiceContext(ctx context.Context) (name string, err error) {
+	var data activeRoleService
+	if err = c.getSecurityConfig(ctx, "/security/activeroleservice", &data); err != nil {
+		return
+	}
+
+	return data.Name, nil
+}
+
+// SetActiveRoleService makes GeoServer use a role service, which must exist
+func (c *Client) SetActiveRoleService(name string) (err error) {
+	return c.SetActiveRoleServiceContext(context.Background(), name)
+}
+
+// SetActiveRoleServiceContext is like SetActiveRoleService but carries ctx down to the HTTP requests
+func (c *Client) SetActiveRoleServiceContext(ctx context.Context, name string) (err error) {
+	return c.writeSecurityConfig(ctx, "PUT", "/security/activeroleservice", activeRoleService{Name: name})
+}
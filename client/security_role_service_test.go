@@ -0,0 +1,154 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRoleServiceSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/roleservices/ldap")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<org.geoserver.security.ldap.LDAPRoleServiceConfig>
+<name>ldap</name>
+<className>org.geoserver.security.ldap.LDAPRoleService</className>
+<adminRoleName>ROLE_ADMINISTRATOR</adminRoleName>
+<serverURL>ldap://ldap.example.com:389/dc=example,dc=com</serverURL>
+<groupSearchBase>ou=groups</groupSearchBase>
+<groupSearchFilter>member=uid={1},ou=people,dc=example,dc=com</groupSearchFilter>
+<allGroupsSearchFilter>cn=*</allGroupsSearchFilter>
+<bindBeforeGroupSearch>true</bindBeforeGroupSearch>
+<user>cn=admin,dc=example,dc=com</user>
+<password>secret</password>
+<lookupUserForDn>false</lookupUserForDn>
+</org.geoserver.security.ldap.LDAPRoleServiceConfig>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	service, err := cli.GetRoleService("ldap")
+
+	assert.Nil(t, err)
+	assert.Equal(t, RoleServiceConfigLDAP, service.ConfigClass)
+	assert.Equal(t, RoleServiceClassLDAP, service.ClassName)
+	assert.Equal(t, "ROLE_ADMINISTRATOR", service.AdminRoleName)
+	assert.Equal(t, "ou=groups", service.GroupSearchBase)
+	assert.Equal(t, "cn=*", service.AllGroupsSearchFilter)
+	assert.True(t, service.BindBeforeGroupSearch)
+	assert.Equal(t, "cn=admin,dc=example,dc=com", service.User)
+	assert.Equal(t, "secret", service.Password)
+	assert.Len(t, service.Properties, 1)
+	assert.Equal(t, "lookupUserForDn", service.Properties[0].XMLName.Local)
+}
+
+func TestCreateRoleServiceSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/security/roleservices")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<org.geoserver.security.xml.XMLRoleServiceConfig><name>custom</name><className>org.geoserver.security.xml.XMLRoleService</className><adminRoleName>ADMIN</adminRoleName><groupAdminRoleName>GROUP_ADMIN</groupAdminRoleName><fileName>roles.xml</fileName><checkInterval>10000</checkInterval><validating>true</validating></org.geoserver.security.xml.XMLRoleServiceConfig>`, string(rawBody))
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateRoleService(&RoleService{
+		ConfigClass:        RoleServiceConfigXML,
+		Name:               "custom",
+		ClassName:          RoleServiceClassXML,
+		AdminRoleName:      "ADMIN",
+		GroupAdminRoleName: "GROUP_ADMIN",
+		FileName:           "roles.xml",
+		CheckInterval:      10000,
+		IsValidating:       true,
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestRoleServiceRequests(t *testing.T) {
+	var calls []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<roleServices><roleService><name>default</name></roleService><roleService><name>jdbc</name></roleService></roleServices>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	names, err := cli.GetRoleServiceNames()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"default", "jdbc"}, names)
+	assert.Nil(t, cli.UpdateRoleService("jdbc", &RoleService{
+		ConfigClass: RoleServiceConfigJDBC,
+		Name:        "jdbc",
+		ClassName:   RoleServiceClassJDBC,
+		IsJNDI:      true,
+		JNDIName:    "java:comp/env/jdbc/security",
+	}))
+	assert.Nil(t, cli.DeleteRoleService("jdbc"))
+	assert.Equal(t, []string{
+		"GET /security/roleservices",
+		"PUT /security/roleservices/jdbc",
+		"DELETE /security/roleservices/jdbc",
+	}, calls)
+}
+
+func TestActiveRoleService(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/security/activeroleservice")
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(200)
+			w.Write([]byte(`<activeRoleService><name>default</name></activeRoleService>`))
+		case "PUT":
+			rawBody, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			if string(rawBody) != `<activeRoleService><name>ldap</name></activeRoleService>` {
+				w.WriteHeader(404)
+				return
+			}
+			w.WriteHeader(200)
+		}
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	name, err := cli.GetActiveRoleService()
+	assert.Nil(t, err)
+	assert.Equal(t, "default", name)
+
+	err = cli.SetActiveRoleService("ldap")
+	assert.Nil(t, err)
+
+	err = cli.SetActiveRoleService("unknown")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
@@ -0,0 +1,223 @@
+package client
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRolesSuccess(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/security/roles", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<roles><role>ADMIN</role><role>GROUP_ADMIN</role></roles>`))
+	})
+	mux.HandleFunc("/security/roles/service/ldap/user/wile", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<roles><role>ROLE_PARTNER</role></roles>`))
+	})
+	mux.HandleFunc("/security/roles/group/acme", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<roles/>`))
+	})
+
+	testServer := httptest.NewServer(mux)
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	roles, err := cli.GetRoles("")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ADMIN", "GROUP_ADMIN"}, roles)
+
+	roles, err = cli.GetUserRoles("ldap", "wile")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ROLE_PARTNER"}, roles)
+
+	roles, err = cli.GetGroupRoles("", "acme")
+	assert.Nil(t, err)
+	assert.Empty(t, roles)
+}
+
+func TestRoleRequests(t *testing.T) {
+	var calls []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	assert.Nil(t, cli.CreateRole("", "ROLE_PARTNER"))
+	assert.Nil(t, cli.AssignRoleToUser("", "ROLE_PARTNER", "wile"))
+	assert.Nil(t, cli.UnassignRoleFromUser("", "ROLE_PARTNER", "wile"))
+	assert.Nil(t, cli.AssignRoleToGroup("custom", "ROLE_PARTNER", "acme"))
+	assert.Nil(t, cli.UnassignRoleFromGroup("custom", "ROLE_PARTNER", "acme"))
+	assert.Nil(t, cli.DeleteRole("custom", "ROLE_PARTNER"))
+	assert.Equal(t, []string{
+		"POST /security/roles/role/ROLE_PARTNER",
+		"POST /security/roles/role/ROLE_PARTNER/user/wile",
+		"DELETE /security/roles/role/ROLE_PARTNER/user/wile",
+		"POST /security/roles/service/custom/role/ROLE_PARTNER/group/acme",
+		"DELETE /security/roles/service/custom/role/ROLE_PARTNER/group/acme",
+		"DELETE /security/roles/service/custom/role/ROLE_PARTNER",
+	}, calls)
+}
+
+func TestCreateRoleConflict(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(409)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateRole("", "ADMIN")
+
+	assert.True(t, errors.Is(err, ErrConflict))
+}
+
+const rolesXML = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<roleRegistry xmlns="http://www.geoserver.org/security/roles" version="1.0">
+<roleList>
+<role id="ADMIN"/>
+<role id="ROLE_PARTNER"/>
+<role id="ROLE_ACME" parentID="ROLE_PARTNER">
+<property name="organization">acme</property>
+</role>
+</roleList>
+<userList>
+<userRoles username="admin">
+<roleRef roleID="ADMIN"/>
+</userRoles>
+</userList>
+<groupList>
+<groupRoles groupname="partners">
+<roleRef roleID="ROLE_PARTNER"/>
+</groupRoles>
+</groupList>
+</roleRegistry>
+`
+
+// newRoleServiceServer serves the roles file of the custom role service,
+// the active one, having the given configuration class
+func newRoleServiceServer(t *testing.T, configClass string, onWrite func(content string)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /security/activeroleservice":
+			w.WriteHeader(200)
+			w.Write([]byte(`<activeRoleService><name>custom</name></activeRoleService>`))
+		case "GET /security/roleservices/custom":
+			w.WriteHeader(200)
+			w.Write([]byte(`<` + configClass + `><name>custom</name><fileName>custom.xml</fileName></` + configClass + `>`))
+		case "GET /resource/security/role/custom/custom.xml":
+			w.WriteHeader(200)
+			w.Write([]byte(rolesXML))
+		case "PUT /resource/security/role/custom/custom.xml":
+			rawBody, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			onWrite(string(rawBody))
+			w.WriteHeader(200)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(404)
+		}
+	}))
+}
+
+func TestGetRoleParentsSuccess(t *testing.T) {
+	testServer := newRoleServiceServer(t, RoleServiceConfigXML, func(content string) {
+		t.Errorf("roles should not be written")
+	})
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	parents, err := cli.GetRoleParents("")
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"ROLE_ACME": "ROLE_PARTNER"}, parents)
+}
+
+func TestSetRoleParent(t *testing.T) {
+	var written string
+	testServer := newRoleServiceServer(t, RoleServiceConfigXML, func(content string) {
+		written = content
+	})
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.SetRoleParent("custom", "ROLE_PARTNER", "ADMIN")
+	assert.Nil(t, err)
+
+	var registry roleRegistry
+	assert.Nil(t, xml.Unmarshal([]byte(written), &registry))
+	assert.Equal(t, "http://www.geoserver.org/security/roles", registry.XMLName.Space)
+	assert.Equal(t, "1.0", registry.Version)
+	assert.Equal(t, []*registryRole{
+		{ID: "ADMIN"},
+		{ID: "ROLE_PARTNER", ParentID: "ADMIN"},
+		{ID: "ROLE_ACME", ParentID: "ROLE_PARTNER", Properties: []*registryRoleProperty{{Name: "organization", Value: "acme"}}},
+	}, registry.Roles.List)
+	assert.Equal(t, "admin", registry.Users.List[0].UserName)
+	assert.Equal(t, "ADMIN", registry.Users.List[0].Refs[0].RoleID)
+	assert.Equal(t, "partners", registry.Groups.List[0].GroupName)
+	assert.Equal(t, "ROLE_PARTNER", registry.Groups.List[0].Refs[0].RoleID)
+
+	err = cli.SetRoleParent("", "ROLE_ACME", "")
+	assert.Nil(t, err)
+	assert.Contains(t, written, `<role id="ROLE_ACME">`)
+
+	err = cli.SetRoleParent("custom", "ROLE_UNKNOWN", "ADMIN")
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	err = cli.SetRoleParent("custom", "ROLE_PARTNER", "ROLE_ACME")
+	assert.NotNil(t, err)
+}
+
+func TestSetRoleParentNotXMLService(t *testing.T) {
+	testServer := newRoleServiceServer(t, RoleServiceConfigJDBC, func(content string) {
+		t.Errorf("roles should not be written")
+	})
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.SetRoleParent("custom", "ROLE_ACME", "ADMIN")
+	assert.EqualError(t, err, "role service custom is not an XML role service, its role hierarchy cannot be edited")
+
+	_, err = cli.GetRoleParents("")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+)
+
+// Configuration classes and implementation classes of the user/group
+// services shipped with GeoServer
+const (
+	UserGroupServiceConfigXML  = "org.geoserver.security.xml.XMLUserGroupServiceConfig"
+	UserGroupServiceClassXML   = "org.geoserver.security.xml.XMLUserGroupService"
+	UserGroupServiceConfigJDBC = "org.geoserver.security.jdbc.config.JDBCUserGroupServiceConfig"
+	UserGroupServiceClassJDBC  = "org.geoserver.security.jdbc.JDBCUserGroupService"
+)
+
+// Names of the password encoders shipped with GeoServer
+const (
+	PasswordEncoderPlainText = "plainTextPasswordEncoder"
+	PasswordEncoderDigest    = "digestPasswordEncoder"
+	PasswordEncoderPBE       = "pbePasswordEncoder"
+	PasswordEncoderStrongPBE = "strongPbePasswordEncoder"
+	PasswordEncoderEmpty     = "emptyPasswordEncoder"
+)
+
+// UserGroupService is the configuration of a user/group service
+type UserGroupService struct {
+	// ConfigClass is the class of the configuration, e.g.
+	// UserGroupServiceConfigXML, used as the root element of the XML
+	ConfigClass         string `xml:"-"`
+	ID                  string `xml:"id,omitempty"`
+	Name                string `xml:"name"`
+	ClassName           string `xml:"className"`
+	PasswordEncoderName string `xml:"passwordEncoderName,omitempty"`
+	PasswordPolicyName  string `xml:"passwordPolicyName,omitempty"`
+	// XML
+	FileName      string `xml:"fileName,omitempty"`
+	CheckInterval int    `xml:"checkInterval,omitempty"`
+	IsValidating  bool   `xml:"validating,omitempty"`
+	// JDBC
+	PropertyFileNameDDL string `xml:"propertyFileNameDDL,omitempty"`
+	PropertyFileNameDML string `xml:"propertyFileNameDML,omitempty"`
+	IsCreatingTables    bool   `xml:"creatingTables,omitempty"`
+	IsJNDI              bool   `xml:"jndi,omitempty"`
+	JNDIName            string `xml:"jndiName,omitempty"`
+	DriverClassName     string `xml:"driverClassName,omitempty"`
+	ConnectURL          string `xml:"connectURL,omitempty"`
+	UserName            string `xml:"userName,omitempty"`
+	Password            string `xml:"password,omitempty"`
+	// Properties are the properties of other services
+	Properties []*SecurityConfigProperty `xml:",any"`
+}
+
+// MarshalXML writes the service with its configuration class as root
+// element
+func (s *UserGroupService) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain UserGroupService
+	start.Name = xml.Name{Local: s.ConfigClass}
+	return e.EncodeElement((*plain)(s), start)
+}
+
+// UnmarshalXML reads the service, keeping its configuration class
+func (s *UserGroupService) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain UserGroupService
+	if err := d.DecodeElement((*plain)(s), &start); err != nil {
+		return err
+	}
+	s.ConfigClass = start.Name.Local
+	return nil
+}
+
+// GetUserGroupServiceNames returns the names of the user/group services
+func (c *Client) GetUserGroupServiceNames() (names []string, err error) {
+	return c.GetUserGroupServiceNamesContext(context.Background())
+}
+
+// GetUserGroupServiceNamesContext is like GetUserGroupServiceNames but carries ctx down to the HTTP requests
+func (c *Client) GetUserGroupServiceNamesContext(ctx context.Context) (names []string, err error) {
+	return c.getSecurityConfigNames(ctx, "/security/usergroupservices")
+}
+
+// GetUserGroupService returns a user/group service based on its name
+func (c *Client) GetUserGroupService(name string) (service *UserGroupService, err error) {
+	return c.GetUserGroupServiceContext(context.Background(), name)
+}
+
+// GetUserGroupServiceContext is like GetUserGroupService but carries ctx down to the HTTP requests
+func (c *Client) GetUserGroupServiceContext(ctx context.Context, name string) (service *UserGroupService, err error) {
+	var data UserGroupService
+	if err = c.getSecurityConfig(ctx, fmt.Sprintf("/security/usergroupservices/%s", name), &data); err != nil {
+		return
+	}
+
+	service = &data
+
+	return
+}
+
+// CreateUserGroupService creates a user/group service
+func (c *Client) CreateUserGroupService(service *UserGroupService) (err error) {
+	return c.CreateUserGroupServiceContext(context.Background(), service)
+}
+
+// CreateUserGroupServiceContext is like CreateUserGroupService but carries ctx down to the HTTP requests
+func (c *Client) CreateUserGroupServiceContext(ctx context.Context, service *UserGroupService) (err error) {
+	return c.writeSecurityConfig(ctx, "POST", "/security/usergroupservices", service)
+}
+
+// UpdateUserGroupService updates a user/group service
+func (c *Client) UpdateUserGroupService(name string, service *UserGroupService) (err error) {
+	return c.UpdateUserGroupServiceContext(context.Background(), name, service)
+}
+
+// UpdateUserGroupServiceContext is like UpdateUserGroupService but carries ctx down to the HTTP requests
+func (c *Client) UpdateUserGroupServiceContext(ctx context.Context, name string, service *UserGroupService) (err error) {
+	return c.writeSecurityConfig(ctx, "PUT", fmt.Sprintf("/security/usergroupservices/%s", name), service)
+}
+
+// DeleteUserGroupService deletes a user/group service, which must not be used
+// by any authentication provider or filter
+func (c *Client) DeleteUserGroupService(name string) (err error) {
+	return c.DeleteUserGroupServiceContext(context.Background(), name)
+}
+
+// DeleteUserGroupServiceContext is like DeleteUserGroupService but carries ctx down to the HTTP requests
+func (c *Client) DeleteUserGroupServiceContext(ctx context.Context, name string) (err error) {
+	return c.deleteSecurityConfig(ctx, fmt.Sprintf("/security/usergroupservices/%s", name))
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUserGroupServiceSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/usergroupservices/default")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<org.geoserver.security.xml.XMLUserGroupServiceConfig>
+<id>-7d7f1ac8:13a82b4c9b3:-7ffe</id>
+<name>default</name>
+<className>org.geoserver.security.xml.XMLUserGroupService</className>
+<fileName>users.xml</fileName>
+<checkInterval>10000</checkInterval>
+<validating>true</validating>
+<passwordEncoderName>pbePasswordEncoder</passwordEncoderName>
+<passwordPolicyName>default</passwordPolicyName>
+</org.geoserver.security.xml.XMLUserGroupServiceConfig>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	service, err := cli.GetUserGroupService("default")
+
+	assert.Nil(t, err)
+	assert.Equal(t, &UserGroupService{
+		ConfigClass:         UserGroupServiceConfigXML,
+		ID:                  "-7d7f1ac8:13a82b4c9b3:-7ffe",
+		Name:                "default",
+		ClassName:           UserGroupServiceClassXML,
+		PasswordEncoderName: PasswordEncoderPBE,
+		PasswordPolicyName:  "default",
+		FileName:            "users.xml",
+		CheckInterval:       10000,
+		IsValidating:        true,
+	}, service)
+}
+
+func TestCreateUserGroupServiceSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/security/usergroupservices")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<org.geoserver.security.jdbc.config.JDBCUserGroupServiceConfig><name>jdbc</name><className>org.geoserver.security.jdbc.JDBCUserGroupService</className><passwordEncoderName>digestPasswordEncoder</passwordEncoderName><passwordPolicyName>default</passwordPolicyName><propertyFileNameDDL>usersddl.xml</propertyFileNameDDL><propertyFileNameDML>usersdml.xml</propertyFileNameDML><creatingTables>true</creatingTables><driverClassName>org.postgresql.Driver</driverClassName><connectURL>jdbc:postgresql://db/geoserver</connectURL><userName>geoserver</userName><password>secret</password></org.geoserver.security.jdbc.config.JDBCUserGroupServiceConfig>`, string(rawBody))
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateUserGroupService(&UserGroupService{
+		ConfigClass:         UserGroupServiceConfigJDBC,
+		Name:                "jdbc",
+		ClassName:           UserGroupServiceClassJDBC,
+		PasswordEncoderName: PasswordEncoderDigest,
+		PasswordPolicyName:  "default",
+		PropertyFileNameDDL: "usersddl.xml",
+		PropertyFileNameDML: "usersdml.xml",
+		IsCreatingTables:    true,
+		DriverClassName:     "org.postgresql.Driver",
+		ConnectURL:          "jdbc:postgresql://db/geoserver",
+		UserName:            "geoserver",
+		Password:            "secret",
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestUserGroupServiceRequests(t *testing.T) {
+	var calls []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<userGroupServices><userGroupService><name>default</name></userGroupService></userGroupServices>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	names, err := cli.GetUserGroupServiceNames()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"default"}, names)
+	assert.Nil(t, cli.UpdateUserGroupService("jdbc", &UserGroupService{
+		ConfigClass: UserGroupServiceConfigJDBC,
+		Name:        "jdbc",
+		ClassName:   UserGroupServiceClassJDBC,
+	}))
+	assert.Nil(t, cli.DeleteUserGroupService("jdbc"))
+	assert.Equal(t, []string{
+		"GET /security/usergroupservices",
+		"PUT /security/usergroupservices/jdbc",
+		"DELETE /security/usergroupservices/jdbc",
+	}, calls)
+}
+
+func TestDeleteUserGroupServiceInUse(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(403)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteUserGroupService("default")
+
+	assert.True(t, errors.Is(err, ErrNotEmpty))
+}
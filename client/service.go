@@ -0,0 +1,124 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+)
+
+// ServiceMetadata is a metadata entry of a service
+type ServiceMetadata = ServiceWmsMetadata
+
+// ServiceKeywords are the keywords of a service
+type ServiceKeywords = ServiceWmsKeywords
+
+// ServiceVersions are the versions supported by a service
+type ServiceVersions struct {
+	List []*ServiceVersion `xml:"org.geotools.util.Version"`
+}
+
+// ServiceMetadataEntries are the metadata entries of a service
+type ServiceMetadataEntries struct {
+	List []*ServiceMetadata `xml:"entry"`
+}
+
+// serviceEndpoint returns the endpoint of the settings of a service, global
+// when workspace is empty
+func serviceEndpoint(service, workspace string) string {
+	if workspace == "" {
+		return fmt.Sprintf("/services/%s/settings", service)
+	}
+
+	return fmt.Sprintf("/services/%s/workspaces/%s/settings", service, workspace)
+}
+
+// getService reads the settings of a service into data
+func (c *Client) getService(ctx context.Context, service, workspace string, data any) (err error) {
+	endpoint := serviceEndpoint(service, workspace)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	return xml.Unmarshal([]byte(body), data)
+}
+
+// updateService writes the settings of a service
+func (c *Client) updateService(ctx context.Context, service, workspace string, data any) (err error) {
+	payload, err := xml.Marshal(data)
+	if err != nil {
+		return
+	}
+
+	endpoint := serviceEndpoint(service, workspace)
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// deleteWorkspaceService removes the workspace specific settings of a service
+func (c *Client) deleteWorkspaceService(ctx context.Context, service, workspace string) (err error) {
+	if workspace == "" {
+		err = fmt.Errorf("Workspace MUST be defined")
+		return
+	}
+
+	endpoint := serviceEndpoint(service, workspace)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 403:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
+		return
+	case 404:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
+		return
+	}
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceEndpoint(t *testing.T) {
+	assert.Equal(t, "/services/wfs/settings", serviceEndpoint("wfs", ""))
+	assert.Equal(t, "/services/wcs/workspaces/topp/settings", serviceEndpoint("wcs", "topp"))
+}
+
+func TestDeleteWorkspaceServiceWithoutWorkspace(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.deleteWorkspaceService(context.Background(), "wfs", "")
+
+	assert.NotNil(t, err)
+}
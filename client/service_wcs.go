@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+// Overview policies of the WCS service
+const (
+	WcsOverviewPolicyIgnore  = "IGNORE"
+	WcsOverviewPolicyNearest = "NEAREST"
+	WcsOverviewPolicyQuality = "QUALITY"
+	WcsOverviewPolicySpeed   = "SPEED"
+)
+
+// ServiceWcsSRS are the SRS advertised by the WCS service
+type ServiceWcsSRS struct {
+	List []string `xml:"string"`
+}
+
+// ServiceWcs is the configuration of the WCS service
+type ServiceWcs struct {
+	XMLName                         xml.Name                `xml:"wcs"`
+	Name                            string                  `xml:"name"`
+	IsEnabled                       bool                    `xml:"enabled"`
+	Title                           string                  `xml:"title,omitempty"`
+	Maintainer                      string                  `xml:"maintainer,omitempty"`
+	Abstract                        string                  `xml:"abstrct,omitempty"`
+	AccessConstraints               string                  `xml:"accessConstraints,omitempty"`
+	Fees                            string                  `xml:"fees,omitempty"`
+	SupportedVersions               *ServiceVersions        `xml:"versions,omitempty"`
+	Keywords                        *ServiceKeywords        `xml:"keywords,omitempty"`
+	IsCiteCompliant                 bool                    `xml:"citeCompliant"`
+	OnlineResource                  string                  `xml:"onlineResource,omitempty"`
+	SchemaBaseURL                   string                  `xml:"schemaBaseURL,omitempty"`
+	IsVerbose                       bool                    `xml:"verbose"`
+	Metadata                        *ServiceMetadataEntries `xml:"metadata,omitempty"`
+	Workspace                       *WorkspaceRef           `xml:"workspace,omitempty"`
+	IsGMLPrefixing                  bool                    `xml:"gmlPrefixing"`
+	IsLatLon                        bool                    `xml:"latLon"`
+	MaximumInputMemory              int                     `xml:"maxInputMemory"`
+	MaximumOutputMemory             int                     `xml:"maxOutputMemory"`
+	MaximumRequestedDimensionValues int                     `xml:"maxRequestedDimensionValues"`
+	OverviewPolicy                  string                  `xml:"overviewPolicy,omitempty"`
+	IsSubsamplingEnabled            bool                    `xml:"subsamplingEnabled"`
+	DefaultDeflateCompressionLevel  int                     `xml:"defaultDeflateCompressionLevel,omitempty"`
+	SRS                             *ServiceWcsSRS          `xml:"srs,omitempty"`
+}
+
+// GetServiceWCS return WCS Service Configuration
+func (c *Client) GetServiceWCS(workspace string) (serviceWcs *ServiceWcs, err error) {
+	return c.GetServiceWCSContext(context.Background(), workspace)
+}
+
+// GetServiceWCSContext is like GetServiceWCS but carries ctx down to the HTTP requests
+func (c *Client) GetServiceWCSContext(ctx context.Context, workspace string) (serviceWcs *ServiceWcs, err error) {
+	var data ServiceWcs
+	if err = c.getService(ctx, "wcs", workspace, &data); err != nil {
+		return
+	}
+
+	serviceWcs = &data
+
+	return
+}
+
+// UpdateServiceWCS update the configuration of a WCS service
+func (c *Client) UpdateServiceWCS(workspace string, serviceWcs *ServiceWcs) (err error) {
+	return c.UpdateServiceWCSContext(context.Background(), workspace, serviceWcs)
+}
+
+// UpdateServiceWCSContext is like UpdateServiceWCS but carries ctx down to the HTTP requests
+func (c *Client) UpdateServiceWCSContext(ctx context.Context, workspace string, serviceWcs *ServiceWcs) (err error) {
+	serviceWcs.XMLName = xml.Name{
+		Local: "wcs",
+	}
+	serviceWcs.Name = "WCS"
+
+	return c.updateService(ctx, "wcs", workspace, serviceWcs)
+}
+
+// DeleteWorkspaceServiceWcs removes the workspace specific WCS settings
+func (c *Client) DeleteWorkspaceServiceWcs(workspace string) (err error) {
+	return c.DeleteWorkspaceServiceWcsContext(context.Background(), workspace)
+}
+
+// DeleteWorkspaceServiceWcsContext is like DeleteWorkspaceServiceWcs but carries ctx down to the HTTP requests
+func (c *Client) DeleteWorkspaceServiceWcsContext(ctx context.Context, workspace string) (err error) {
+	return c.deleteWorkspaceService(ctx, "wcs", workspace)
+}
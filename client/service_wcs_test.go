@@ -0,0 +1,105 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetServiceWcsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/services/wcs/settings")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+<wcs>
+  <id>wcs</id>
+  <enabled>true</enabled>
+  <name>WCS</name>
+  <title>Web Coverage Service</title>
+  <citeCompliant>false</citeCompliant>
+  <verbose>false</verbose>
+  <gmlPrefixing>false</gmlPrefixing>
+  <latLon>false</latLon>
+  <maxInputMemory>65536</maxInputMemory>
+  <maxOutputMemory>131072</maxOutputMemory>
+  <maxRequestedDimensionValues>100</maxRequestedDimensionValues>
+  <overviewPolicy>QUALITY</overviewPolicy>
+  <subsamplingEnabled>true</subsamplingEnabled>
+  <srs>
+    <string>4326</string>
+    <string>3857</string>
+  </srs>
+</wcs>
+`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	service, err := cli.GetServiceWCS("")
+
+	assert.Nil(t, err)
+	assert.True(t, service.IsEnabled)
+	assert.Equal(t, 65536, service.MaximumInputMemory)
+	assert.Equal(t, 131072, service.MaximumOutputMemory)
+	assert.Equal(t, WcsOverviewPolicyQuality, service.OverviewPolicy)
+	assert.True(t, service.IsSubsamplingEnabled)
+	assert.Equal(t, []string{"4326", "3857"}, service.SRS.List)
+}
+
+func TestUpdateServiceWcsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/services/wcs/workspaces/nurc/settings")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Contains(t, string(rawBody), `<wcs><name>WCS</name><enabled>true</enabled>`)
+		assert.Contains(t, string(rawBody), `<maxInputMemory>1024</maxInputMemory><maxOutputMemory>2048</maxOutputMemory>`)
+		assert.Contains(t, string(rawBody), `<overviewPolicy>NEAREST</overviewPolicy><subsamplingEnabled>false</subsamplingEnabled>`)
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateServiceWCS("nurc", &ServiceWcs{
+		IsEnabled:           true,
+		MaximumInputMemory:  1024,
+		MaximumOutputMemory: 2048,
+		OverviewPolicy:      WcsOverviewPolicyNearest,
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteServiceWcsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.Path, "/services/wcs/workspaces/nurc/settings")
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteWorkspaceServiceWcs("nurc")
+
+	assert.Nil(t, err)
+}
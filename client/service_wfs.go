@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+// WFS service levels
+const (
+	WfsServiceLevelBasic         = "BASIC"
+	WfsServiceLevelTransactional = "TRANSACTIONAL"
+	WfsServiceLevelComplete      = "COMPLETE"
+)
+
+// GML versions of the WFS output configuration
+const (
+	WfsGmlVersion10 = "V_10"
+	WfsGmlVersion11 = "V_11"
+	WfsGmlVersion20 = "V_20"
+)
+
+// ServiceWfsGml is the GML output configuration of a WFS version
+type ServiceWfsGml struct {
+	Version string `xml:"version"`
+	// SrsNameStyle is one of NORMAL, XML, URN, URN2 or URL
+	SrsNameStyle          string `xml:"gml>srsNameStyle"`
+	OverrideGMLAttributes bool   `xml:"gml>overrideGMLAttributes"`
+	MimeTypeToForce       string `xml:"gml>mimeTypeToForce,omitempty"`
+}
+
+// ServiceWfsGmls are the GML output configurations of the WFS versions
+type ServiceWfsGmls struct {
+	List []*ServiceWfsGml `xml:"entry"`
+}
+
+// ServiceWfs is the configuration of the WFS service
+type ServiceWfs struct {
+	XMLName                       xml.Name                `xml:"wfs"`
+	Name                          string                  `xml:"name"`
+	IsEnabled                     bool                    `xml:"enabled"`
+	Title                         string                  `xml:"title,omitempty"`
+	Maintainer                    string                  `xml:"maintainer,omitempty"`
+	Abstract                      string                  `xml:"abstrct,omitempty"`
+	AccessConstraints             string                  `xml:"accessConstraints,omitempty"`
+	Fees                          string                  `xml:"fees,omitempty"`
+	SupportedVersions             *ServiceVersions        `xml:"versions,omitempty"`
+	Keywords                      *ServiceKeywords        `xml:"keywords,omitempty"`
+	IsCiteCompliant               bool                    `xml:"citeCompliant"`
+	OnlineResource                string                  `xml:"onlineResource,omitempty"`
+	SchemaBaseURL                 string                  `xml:"schemaBaseURL,omitempty"`
+	IsVerbose                     bool                    `xml:"verbose"`
+	Metadata                      *ServiceMetadataEntries `xml:"metadata,omitempty"`
+	Workspace                     *WorkspaceRef           `xml:"workspace,omitempty"`
+	GML                           *ServiceWfsGmls         `xml:"gml,omitempty"`
+	ServiceLevel                  string                  `xml:"serviceLevel,omitempty"`
+	MaximumFeatures               int                     `xml:"maxFeatures"`
+	IsFeatureBounding             bool                    `xml:"featureBounding"`
+	IsCanonicalSchemaLocation     bool                    `xml:"canonicalSchemaLocation"`
+	IsEncodeFeatureMember         bool                    `xml:"encodeFeatureMember"`
+	IsHitsIgnoreMaxFeatures       bool                    `xml:"hitsIgnoreMaxFeatures"`
+	MaximumFeaturesForPreview     int                     `xml:"maxNumberOfFeaturesForPreview,omitempty"`
+	IsGlobalQueriesAllowed        bool                    `xml:"allowGlobalQueries"`
+	IsSimpleConversionEnabled     bool                    `xml:"simpleConversionEnabled"`
+	IsGetFeatureOutputTypeChecked bool                    `xml:"getFeatureOutputTypeCheckingEnabled"`
+}
+
+// GetServiceWFS return WFS Service Configuration
+func (c *Client) GetServiceWFS(workspace string) (serviceWfs *ServiceWfs, err error) {
+	return c.GetServiceWFSContext(context.Background(), workspace)
+}
+
+// GetServiceWFSContext is like GetServiceWFS but carries ctx down to the HTTP requests
+func (c *Client) GetServiceWFSContext(ctx context.Context, workspace string) (serviceWfs *ServiceWfs, err error) {
+	var data ServiceWfs
+	if err = c.getService(ctx, "wfs", workspace, &data); err != nil {
+		return
+	}
+
+	serviceWfs = &data
+
+	return
+}
+
+// UpdateServiceWFS update the configuration of a WFS service
+func (c *Client) UpdateServiceWFS(workspace string, serviceWfs *ServiceWfs) (err error) {
+	return c.UpdateServiceWFSContext(context.Background(), workspace, serviceWfs)
+}
+
+// UpdateServiceWFSContext is like UpdateServiceWFS but carries ctx down to the HTTP requests
+func (c *Client) UpdateServiceWFSContext(ctx context.Context, workspace string, serviceWfs *ServiceWfs) (err error) {
+	serviceWfs.XMLName = xml.Name{
+		Local: "wfs",
+	}
+	serviceWfs.Name = "WFS"
+
+	return c.updateService(ctx, "wfs", workspace, serviceWfs)
+}
+
+// DeleteWorkspaceServiceWfs removes the workspace specific WFS settings
+func (c *Client) DeleteWorkspaceServiceWfs(workspace string) (err error) {
+	return c.DeleteWorkspaceServiceWfsContext(context.Background(), workspace)
+}
+
+// DeleteWorkspaceServiceWfsContext is like DeleteWorkspaceServiceWfs but carries ctx down to the HTTP requests
+func (c *Client) DeleteWorkspaceServiceWfsContext(ctx context.Context, workspace string) (err error) {
+	return c.deleteWorkspaceService(ctx, "wfs", workspace)
+}
@@ -0,0 +1,169 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetServiceWfsWorkspaceSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/services/wfs/workspaces/topp/settings")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+<wfs>
+  <id>WFSInfoImpl-1</id>
+  <workspace>
+    <name>topp</name>
+  </workspace>
+  <enabled>true</enabled>
+  <name>WFS</name>
+  <title>Topp WFS</title>
+  <versions>
+    <org.geotools.util.Version>
+      <version>1.0.0</version>
+    </org.geotools.util.Version>
+    <org.geotools.util.Version>
+      <version>2.0.0</version>
+    </org.geotools.util.Version>
+  </versions>
+  <keywords>
+    <string>WFS</string>
+    <string>GEOSERVER</string>
+  </keywords>
+  <citeCompliant>false</citeCompliant>
+  <verbose>false</verbose>
+  <metadata>
+    <entry key="SHAPE-ZIP_DEFAULT_PRJ_IS_ESRI">false</entry>
+  </metadata>
+  <gml>
+    <entry>
+      <version>V_10</version>
+      <gml>
+        <srsNameStyle>XML</srsNameStyle>
+        <overrideGMLAttributes>true</overrideGMLAttributes>
+      </gml>
+    </entry>
+    <entry>
+      <version>V_20</version>
+      <gml>
+        <srsNameStyle>URN2</srsNameStyle>
+        <overrideGMLAttributes>false</overrideGMLAttributes>
+        <mimeTypeToForce>application/gml+xml; version=3.2</mimeTypeToForce>
+      </gml>
+    </entry>
+  </gml>
+  <serviceLevel>TRANSACTIONAL</serviceLevel>
+  <maxFeatures>5000</maxFeatures>
+  <featureBounding>true</featureBounding>
+  <canonicalSchemaLocation>false</canonicalSchemaLocation>
+  <encodeFeatureMember>false</encodeFeatureMember>
+  <hitsIgnoreMaxFeatures>true</hitsIgnoreMaxFeatures>
+</wfs>
+`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	service, err := cli.GetServiceWFS("topp")
+
+	assert.Nil(t, err)
+	assert.Equal(t, &WorkspaceRef{Name: "topp"}, service.Workspace)
+	assert.Equal(t, WfsServiceLevelTransactional, service.ServiceLevel)
+	assert.Equal(t, 5000, service.MaximumFeatures)
+	assert.True(t, service.IsFeatureBounding)
+	assert.True(t, service.IsHitsIgnoreMaxFeatures)
+	assert.Equal(t, []string{"WFS", "GEOSERVER"}, service.Keywords.Keywords)
+	assert.Len(t, service.SupportedVersions.List, 2)
+	assert.Equal(t, "2.0.0", service.SupportedVersions.List[1].Version)
+	assert.Equal(t, &ServiceWfsGmls{List: []*ServiceWfsGml{
+		{
+			Version:               WfsGmlVersion10,
+			SrsNameStyle:          "XML",
+			OverrideGMLAttributes: true,
+		},
+		{
+			Version:         WfsGmlVersion20,
+			SrsNameStyle:    "URN2",
+			MimeTypeToForce: "application/gml+xml; version=3.2",
+		},
+	}}, service.GML)
+}
+
+func TestGetServiceWfsNotFound(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	service, err := cli.GetServiceWFS("topp")
+
+	assert.Nil(t, service)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestUpdateServiceWfsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/services/wfs/settings")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Contains(t, string(rawBody), `<wfs><name>WFS</name><enabled>true</enabled>`)
+		assert.Contains(t, string(rawBody), `<gml><entry><version>V_11</version><gml><srsNameStyle>URN</srsNameStyle><overrideGMLAttributes>false</overrideGMLAttributes></gml></entry></gml>`)
+		assert.Contains(t, string(rawBody), `<serviceLevel>COMPLETE</serviceLevel><maxFeatures>1000</maxFeatures>`)
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateServiceWFS("", &ServiceWfs{
+		IsEnabled: true,
+		GML: &ServiceWfsGmls{List: []*ServiceWfsGml{
+			{Version: WfsGmlVersion11, SrsNameStyle: "URN"},
+		}},
+		ServiceLevel:    WfsServiceLevelComplete,
+		MaximumFeatures: 1000,
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteServiceWfsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.Path, "/services/wfs/workspaces/topp/settings")
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteWorkspaceServiceWfs("topp")
+
+	assert.Nil(t, err)
+}
@@ -1,9 +1,8 @@
 package client
 
 import (
-	"bytes"
+	"context"
 	"encoding/xml"
-	"fmt"
 )
 
 type ServiceWmsMetadata struct {
@@ -73,36 +72,14 @@ type CacheConfiguration struct {
 
 // GetServiceWMS return WMS Service Configuration
 func (c *Client) GetServiceWMS(workspace string) (serviceWms *ServiceWms, err error) {
-	var endpoint string
-
-	if workspace == "" {
-		endpoint = "/services/wms/settings"
-	} else {
-		endpoint = fmt.Sprintf("/services/wms/workspaces/%s/settings", workspace)
-	}
-
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
-	if err != nil {
-		return
-	}
-
-	switch statusCode {
-	case 401:
-		err = fmt.Errorf("unauthorized")
-		return
-	case 404:
-		err = fmt.Errorf("not found")
-		return
-	case 200:
-		break
-	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
-		return
-	}
+	return c.GetServiceWMSContext(context.Background(), workspace)
+}
 
+// GetServiceWMSContext is like GetServiceWMS but carries ctx down to the HTTP requests
+func (c *Client) GetServiceWMSContext(ctx context.Context, workspace string) (serviceWms *ServiceWms, err error) {
 	var data ServiceWms
-	if err := xml.Unmarshal([]byte(body), &data); err != nil {
-		return serviceWms, err
+	if err = c.getService(ctx, "wms", workspace, &data); err != nil {
+		return
 	}
 
 	serviceWms = &data
@@ -112,75 +89,25 @@ func (c *Client) GetServiceWMS(workspace string) (serviceWms *ServiceWms, err er
 
 // UpdateServiceWMS update the configuration of a WMS service
 func (c *Client) UpdateServiceWMS(workspace string, serviceWms *ServiceWms) (err error) {
-	var endpoint string
-
-	if workspace == "" {
-		endpoint = "/services/wms/settings"
-	} else {
-		endpoint = fmt.Sprintf("/services/wms/workspaces/%s/settings", workspace)
-	}
+	return c.UpdateServiceWMSContext(context.Background(), workspace, serviceWms)
+}
 
+// UpdateServiceWMSContext is like UpdateServiceWMS but carries ctx down to the HTTP requests
+func (c *Client) UpdateServiceWMSContext(ctx context.Context, workspace string, serviceWms *ServiceWms) (err error) {
 	serviceWms.XMLName = xml.Name{
 		Local: "wms",
 	}
 	serviceWms.Name = "WMS"
-	payload, _ := xml.Marshal(serviceWms)
 
-	statusCode, body, err := c.doRequest("PUT", endpoint, bytes.NewBuffer(payload))
-	if err != nil {
-		return
-	}
-
-	switch statusCode {
-	case 401:
-		err = fmt.Errorf("unauthorized")
-		return
-	case 404:
-		err = fmt.Errorf("not found")
-		return
-	case 405:
-		err = fmt.Errorf("forbidden")
-		return
-	case 200:
-		return
-	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
-		return
-	}
+	return c.updateService(ctx, "wms", workspace, serviceWms)
 }
 
+// DeleteWorkspaceServiceWms removes the workspace specific WMS settings
 func (c *Client) DeleteWorkspaceServiceWms(workspace string) (err error) {
-	var endpoint string
-
-	if workspace == "" {
-		err = fmt.Errorf("Workspace MUST be defined")
-		return
-	} else {
-		endpoint = fmt.Sprintf("/services/wms/workspaces/%s/settings", workspace)
-	}
-
-	statusCode, body, err := c.doRequest("DELETE", endpoint, nil)
-	if err != nil {
-		return
-	}
+	return c.DeleteWorkspaceServiceWmsContext(context.Background(), workspace)
+}
 
-	switch statusCode {
-	case 401:
-		err = fmt.Errorf("unauthorized")
-		return
-	case 403:
-		err = fmt.Errorf("workspace is not empty")
-		return
-	case 404:
-		err = fmt.Errorf("not found")
-		return
-	case 405:
-		err = fmt.Errorf("forbidden")
-		return
-	case 200:
-		return
-	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
-		return
-	}
+// DeleteWorkspaceServiceWmsContext is like DeleteWorkspaceServiceWms but carries ctx down to the HTTP requests
+func (c *Client) DeleteWorkspaceServiceWmsContext(ctx context.Context, workspace string) (err error) {
+	return c.deleteWorkspaceService(ctx, "wms", workspace)
 }
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+// ServiceWmts is the configuration of the WMTS service
+type ServiceWmts struct {
+	XMLName           xml.Name                `xml:"wmts"`
+	Name              string                  `xml:"name"`
+	IsEnabled         bool                    `xml:"enabled"`
+	Title             string                  `xml:"title,omitempty"`
+	Maintainer        string                  `xml:"maintainer,omitempty"`
+	Abstract          string                  `xml:"abstrct,omitempty"`
+	AccessConstraints string                  `xml:"accessConstraints,omitempty"`
+	Fees              string                  `xml:"fees,omitempty"`
+	SupportedVersions *ServiceVersions        `xml:"versions,omitempty"`
+	Keywords          *ServiceKeywords        `xml:"keywords,omitempty"`
+	IsCiteCompliant   bool                    `xml:"citeCompliant"`
+	OnlineResource    string                  `xml:"onlineResource,omitempty"`
+	SchemaBaseURL     string                  `xml:"schemaBaseURL,omitempty"`
+	IsVerbose         bool                    `xml:"verbose"`
+	Metadata          *ServiceMetadataEntries `xml:"metadata,omitempty"`
+	Workspace         *WorkspaceRef           `xml:"workspace,omitempty"`
+}
+
+// GetServiceWMTS return WMTS Service Configuration
+func (c *Client) GetServiceWMTS(workspace string) (serviceWmts *ServiceWmts, err error) {
+	return c.GetServiceWMTSContext(context.Background(), workspace)
+}
+
+// GetServiceWMTSContext is like GetServiceWMTS but carries ctx down to the HTTP requests
+func (c *Client) GetServiceWMTSContext(ctx context.Context, workspace string) (serviceWmts *ServiceWmts, err error) {
+	var data ServiceWmts
+	if err = c.getService(ctx, "wmts", workspace, &data); err != nil {
+		return
+	}
+
+	serviceWmts = &data
+
+	return
+}
+
+// UpdateServiceWMTS update the configuration of a WMTS service
+func (c *Client) UpdateServiceWMTS(workspace string, serviceWmts *ServiceWmts) (err error) {
+	return c.UpdateServiceWMTSContext(context.Background(), workspace, serviceWmts)
+}
+
+// UpdateServiceWMTSContext is like UpdateServiceWMTS but carries ctx down to the HTTP requests
+func (c *Client) UpdateServiceWMTSContext(ctx context.Context, workspace string, serviceWmts *ServiceWmts) (err error) {
+	serviceWmts.XMLName = xml.Name{
+		Local: "wmts",
+	}
+	serviceWmts.Name = "WMTS"
+
+	return c.updateService(ctx, "wmts", workspace, serviceWmts)
+}
+
+// DeleteWorkspaceServiceWmts removes the workspace specific WMTS settings
+func (c *Client) DeleteWorkspaceServiceWmts(workspace string) (err error) {
+	return c.DeleteWorkspaceServiceWmtsContext(context.Background(), workspace)
+}
+
+// DeleteWorkspaceServiceWmtsContext is like DeleteWorkspaceServiceWmts but carries ctx down to the HTTP requests
+func (c *Client) DeleteWorkspaceServiceWmtsContext(ctx context.Context, workspace string) (err error) {
+	return c.deleteWorkspaceService(ctx, "wmts", workspace)
+}
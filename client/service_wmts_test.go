@@ -0,0 +1,95 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetServiceWmtsWorkspaceSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/services/wmts/workspaces/topp/settings")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+<wmts>
+  <workspace>
+    <name>topp</name>
+  </workspace>
+  <enabled>true</enabled>
+  <name>WMTS</name>
+  <title>Topp tiles</title>
+  <abstrct>Tiles of the topp layers</abstrct>
+  <keywords>
+    <string>WMTS</string>
+  </keywords>
+  <citeCompliant>false</citeCompliant>
+  <verbose>false</verbose>
+</wmts>
+`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	service, err := cli.GetServiceWMTS("topp")
+
+	assert.Nil(t, err)
+	assert.True(t, service.IsEnabled)
+	assert.Equal(t, "Topp tiles", service.Title)
+	assert.Equal(t, "Tiles of the topp layers", service.Abstract)
+	assert.Equal(t, []string{"WMTS"}, service.Keywords.Keywords)
+	assert.Equal(t, &WorkspaceRef{Name: "topp"}, service.Workspace)
+}
+
+func TestUpdateServiceWmtsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/services/wmts/workspaces/topp/settings")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<wmts><name>WMTS</name><enabled>false</enabled><title>Topp tiles</title><keywords><string>tiles</string></keywords><citeCompliant>false</citeCompliant><verbose>false</verbose></wmts>`, string(rawBody))
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateServiceWMTS("topp", &ServiceWmts{
+		Title:    "Topp tiles",
+		Keywords: &ServiceKeywords{Keywords: []string{"tiles"}},
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteServiceWmtsUnauthorized(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+
+		w.WriteHeader(401)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteWorkspaceServiceWmts("topp")
+
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+// ServiceWpsProcess restricts the access to a single process of a group.
+// Validators are kept as is so that they survive an update
+type ServiceWpsProcess struct {
+	Name       string       `xml:"name"`
+	IsEnabled  bool         `xml:"enabled"`
+	Roles      []string     `xml:"roles>string,omitempty"`
+	Validators *RawSettings `xml:"validators,omitempty"`
+}
+
+// ServiceWpsProcessGroup restricts the access to the processes of a factory,
+// e.g. org.geoserver.wps.jts.SpringBeanProcessFactory for the JTS processes
+type ServiceWpsProcessGroup struct {
+	FactoryClass      string               `xml:"factoryClass"`
+	IsEnabled         bool                 `xml:"enabled"`
+	Roles             []string             `xml:"roles>string,omitempty"`
+	FilteredProcesses []*ServiceWpsProcess `xml:"filteredProcesses>accessInfo,omitempty"`
+}
+
+// ServiceWpsProcessGroups are the process groups of the WPS service
+type ServiceWpsProcessGroups struct {
+	List []*ServiceWpsProcessGroup `xml:"processGroup"`
+}
+
+// ServiceWps is the configuration of the WPS service
+type ServiceWps struct {
+	XMLName                      xml.Name                 `xml:"wps"`
+	Name                         string                   `xml:"name"`
+	IsEnabled                    bool                     `xml:"enabled"`
+	Title                        string                   `xml:"title,omitempty"`
+	Maintainer                   string                   `xml:"maintainer,omitempty"`
+	Abstract                     string                   `xml:"abstrct,omitempty"`
+	AccessConstraints            string                   `xml:"accessConstraints,omitempty"`
+	Fees                         string                   `xml:"fees,omitempty"`
+	SupportedVersions            *ServiceVersions         `xml:"versions,omitempty"`
+	Keywords                     *ServiceKeywords         `xml:"keywords,omitempty"`
+	IsCiteCompliant              bool                     `xml:"citeCompliant"`
+	OnlineResource               string                   `xml:"onlineResource,omitempty"`
+	SchemaBaseURL                string                   `xml:"schemaBaseURL,omitempty"`
+	IsVerbose                    bool                     `xml:"verbose"`
+	Metadata                     *ServiceMetadataEntries  `xml:"metadata,omitempty"`
+	Workspace                    *WorkspaceRef            `xml:"workspace,omitempty"`
+	ConnectionTimeout            float64                  `xml:"connectionTimeout"`
+	ResourceExpirationTimeout    int                      `xml:"resourceExpirationTimeout"`
+	MaximumSynchronousProcesses  int                      `xml:"maxSynchronousProcesses"`
+	MaximumAsynchronousProcesses int                      `xml:"maxAsynchronousProcesses"`
+	ProcessGroups                *ServiceWpsProcessGroups `xml:"processGroups,omitempty"`
+	StorageDirectory             string                   `xml:"storageDirectory,omitempty"`
+	CatalogMode                  string                   `xml:"catalogMode,omitempty"`
+	MaximumComplexInputSize      int                      `xml:"maxComplexInputSize"`
+	IsRemoteInputDisabled        bool                     `xml:"remoteInputDisabled"`
+}
+
+// ProcessGroup returns the process group of the given factory, or nil when
+// the service has none
+func (s *ServiceWps) ProcessGroup(factoryClass string) *ServiceWpsProcessGroup {
+	if s.ProcessGroups == nil {
+		return nil
+	}
+
+	for _, group := range s.ProcessGroups.List {
+		if group.FactoryClass == factoryClass {
+			return group
+		}
+	}
+
+	return nil
+}
+
+// Process returns the filter of the named process, or nil when the process
+// is not filtered
+func (g *ServiceWpsProcessGroup) Process(name string) *ServiceWpsProcess {
+	for _, process := range g.FilteredProcesses {
+		if process.Name == name {
+			return process
+		}
+	}
+
+	return nil
+}
+
+// GetServiceWPS return WPS Service Configuration
+func (c *Client) GetServiceWPS(workspace string) (serviceWps *ServiceWps, err error) {
+	return c.GetServiceWPSContext(context.Background(), workspace)
+}
+
+// GetServiceWPSContext is like GetServiceWPS but carries ctx down to the HTTP requests
+func (c *Client) GetServiceWPSContext(ctx context.Context, workspace string) (serviceWps *ServiceWps, err error) {
+	var data ServiceWps
+	if err = c.getService(ctx, "wps", workspace, &data); err != nil {
+		return
+	}
+
+	serviceWps = &data
+
+	return
+}
+
+// UpdateServiceWPS update the configuration of a WPS service
+func (c *Client) UpdateServiceWPS(workspace string, serviceWps *ServiceWps) (err error) {
+	return c.UpdateServiceWPSContext(context.Background(), workspace, serviceWps)
+}
+
+// UpdateServiceWPSContext is like UpdateServiceWPS but carries ctx down to the HTTP requests
+func (c *Client) UpdateServiceWPSContext(ctx context.Context, workspace string, serviceWps *ServiceWps) (err error) {
+	serviceWps.XMLName = xml.Name{
+		Local: "wps",
+	}
+	serviceWps.Name = "WPS"
+
+	return c.updateService(ctx, "wps", workspace, serviceWps)
+}
+
+// DeleteWorkspaceServiceWps removes the workspace specific WPS settings
+func (c *Client) DeleteWorkspaceServiceWps(workspace string) (err error) {
+	return c.DeleteWorkspaceServiceWpsContext(context.Background(), workspace)
+}
+
+// DeleteWorkspaceServiceWpsContext is like DeleteWorkspaceServiceWps but carries ctx down to the HTTP requests
+func (c *Client) DeleteWorkspaceServiceWpsContext(ctx context.Context, workspace string) (err error) {
+	return c.deleteWorkspaceService(ctx, "wps", workspace)
+}
@@ -0,0 +1,194 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const jtsFactory = "org.geoserver.wps.jts.SpringBeanProcessFactory"
+
+func TestGetServiceWpsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/services/wps/settings")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+<wps>
+  <id>wps</id>
+  <enabled>true</enabled>
+  <name>WPS</name>
+  <citeCompliant>false</citeCompliant>
+  <verbose>false</verbose>
+  <connectionTimeout>30.0</connectionTimeout>
+  <resourceExpirationTimeout>300</resourceExpirationTimeout>
+  <maxSynchronousProcesses>4</maxSynchronousProcesses>
+  <maxAsynchronousProcesses>2</maxAsynchronousProcesses>
+  <processGroups>
+    <processGroup>
+      <factoryClass>org.geoserver.wps.jts.SpringBeanProcessFactory</factoryClass>
+      <enabled>true</enabled>
+      <filteredProcesses>
+        <accessInfo>
+          <name>JTS:buffer</name>
+          <enabled>true</enabled>
+          <roles>
+            <string>ROLE_ANALYST</string>
+          </roles>
+          <validators>
+            <entry>
+              <string>geom</string>
+              <maxSizeValidator>
+                <maxSizeMB>10</maxSizeMB>
+              </maxSizeValidator>
+            </entry>
+          </validators>
+        </accessInfo>
+        <accessInfo>
+          <name>JTS:union</name>
+          <enabled>false</enabled>
+        </accessInfo>
+      </filteredProcesses>
+      <roles/>
+    </processGroup>
+    <processGroup>
+      <factoryClass>org.geoserver.wps.gs.GeoServerProcessFactory</factoryClass>
+      <enabled>false</enabled>
+      <roles>
+        <string>ADMIN</string>
+      </roles>
+    </processGroup>
+  </processGroups>
+  <catalogMode>HIDE</catalogMode>
+  <maxComplexInputSize>0</maxComplexInputSize>
+  <remoteInputDisabled>true</remoteInputDisabled>
+</wps>
+`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	service, err := cli.GetServiceWPS("")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 30.0, service.ConnectionTimeout)
+	assert.Equal(t, 300, service.ResourceExpirationTimeout)
+	assert.Equal(t, 4, service.MaximumSynchronousProcesses)
+	assert.Equal(t, 2, service.MaximumAsynchronousProcesses)
+	assert.True(t, service.IsRemoteInputDisabled)
+	assert.Len(t, service.ProcessGroups.List, 2)
+
+	jts := service.ProcessGroup(jtsFactory)
+	assert.True(t, jts.IsEnabled)
+	buffer := jts.Process("JTS:buffer")
+	assert.True(t, buffer.IsEnabled)
+	assert.Equal(t, []string{"ROLE_ANALYST"}, buffer.Roles)
+	assert.Contains(t, buffer.Validators.Content, "<maxSizeMB>10</maxSizeMB>")
+	assert.False(t, jts.Process("JTS:union").IsEnabled)
+	assert.Nil(t, jts.Process("JTS:area"))
+
+	gs := service.ProcessGroup("org.geoserver.wps.gs.GeoServerProcessFactory")
+	assert.False(t, gs.IsEnabled)
+	assert.Equal(t, []string{"ADMIN"}, gs.Roles)
+
+	assert.Nil(t, service.ProcessGroup("org.example.Unknown"))
+}
+
+func TestUpdateServiceWpsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/services/wps/workspaces/topp/settings")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Contains(t, string(rawBody), `<wps><name>WPS</name><enabled>true</enabled>`)
+		assert.Contains(t, string(rawBody), `<connectionTimeout>60</connectionTimeout><resourceExpirationTimeout>600</resourceExpirationTimeout><maxSynchronousProcesses>2</maxSynchronousProcesses><maxAsynchronousProcesses>1</maxAsynchronousProcesses>`)
+		assert.Contains(t, string(rawBody), `<processGroups><processGroup><factoryClass>org.geoserver.wps.jts.SpringBeanProcessFactory</factoryClass><enabled>true</enabled><roles><string>ROLE_ANALYST</string></roles><filteredProcesses><accessInfo><name>JTS:buffer</name><enabled>false</enabled><roles></roles></accessInfo></filteredProcesses></processGroup></processGroups>`)
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateServiceWPS("topp", &ServiceWps{
+		IsEnabled:                    true,
+		ConnectionTimeout:            60,
+		ResourceExpirationTimeout:    600,
+		MaximumSynchronousProcesses:  2,
+		MaximumAsynchronousProcesses: 1,
+		ProcessGroups: &ServiceWpsProcessGroups{List: []*ServiceWpsProcessGroup{
+			{
+				FactoryClass: jtsFactory,
+				IsEnabled:    true,
+				Roles:        []string{"ROLE_ANALYST"},
+				FilteredProcesses: []*ServiceWpsProcess{
+					{Name: "JTS:buffer"},
+				},
+			},
+		}},
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteServiceWpsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.Path, "/services/wps/workspaces/topp/settings")
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteWorkspaceServiceWps("topp")
+
+	assert.Nil(t, err)
+}
+
+func TestUpdateServiceWpsKeepsValidators(t *testing.T) {
+	validators := `<entry><string>geom</string><maxSizeValidator><maxSizeMB>10</maxSizeMB></maxSizeValidator></entry>`
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			w.WriteHeader(200)
+			w.Write([]byte(`<wps><processGroups><processGroup><factoryClass>` + jtsFactory + `</factoryClass><enabled>true</enabled><filteredProcesses><accessInfo><name>JTS:buffer</name><enabled>true</enabled><validators>` + validators + `</validators></accessInfo></filteredProcesses></processGroup></processGroups></wps>`))
+			return
+		}
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Contains(t, string(rawBody), `<accessInfo><name>JTS:buffer</name><enabled>false</enabled><roles></roles><validators>`+validators+`</validators></accessInfo>`)
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	service, err := cli.GetServiceWPS("")
+	assert.Nil(t, err)
+	service.ProcessGroup(jtsFactory).Process("JTS:buffer").IsEnabled = false
+
+	err = cli.UpdateServiceWPS("", service)
+
+	assert.Nil(t, err)
+}
@@ -0,0 +1,320 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+)
+
+// Contact is the contact information advertised by the services
+type Contact struct {
+	XMLName                      xml.Name `xml:"contact"`
+	ID                           string   `xml:"id,omitempty"`
+	Address                      string   `xml:"address,omitempty"`
+	AddressCity                  string   `xml:"addressCity,omitempty"`
+	AddressCountry               string   `xml:"addressCountry,omitempty"`
+	AddressDeliveryPoint         string   `xml:"addressDeliveryPoint,omitempty"`
+	AddressElectronicMailAddress string   `xml:"addressElectronicMailAddress,omitempty"`
+	AddressPostalCode            string   `xml:"addressPostalCode,omitempty"`
+	AddressState                 string   `xml:"addressState,omitempty"`
+	AddressType                  string   `xml:"addressType,omitempty"`
+	ContactEmail                 string   `xml:"contactEmail,omitempty"`
+	ContactFacsimile             string   `xml:"contactFacsimile,omitempty"`
+	ContactOrganization          string   `xml:"contactOrganization,omitempty"`
+	ContactPerson                string   `xml:"contactPerson,omitempty"`
+	ContactPosition              string   `xml:"contactPosition,omitempty"`
+	ContactVoice                 string   `xml:"contactVoice,omitempty"`
+	OnlineResource               string   `xml:"onlineResource,omitempty"`
+	Welcome                      string   `xml:"welcome,omitempty"`
+}
+
+// Settings are the settings of the instance, or of a workspace when they are
+// local to it
+type Settings struct {
+	XMLName                            xml.Name      `xml:"settings"`
+	ID                                 string        `xml:"id,omitempty"`
+	Workspace                          *WorkspaceRef `xml:"workspace,omitempty"`
+	Contact                            *Contact      `xml:"contact,omitempty"`
+	Charset                            string        `xml:"charset,omitempty"`
+	NumDecimals                        int           `xml:"numDecimals"`
+	OnlineResource                     string        `xml:"onlineResource,omitempty"`
+	ProxyBaseURL                       string        `xml:"proxyBaseUrl,omitempty"`
+	UseHeadersProxyURL                 bool          `xml:"useHeadersProxyURL"`
+	SchemaBaseURL                      string        `xml:"schemaBaseUrl,omitempty"`
+	IsVerbose                          bool          `xml:"verbose"`
+	IsVerboseExceptions                bool          `xml:"verboseExceptions"`
+	LocalWorkspaceIncludesPrefix       bool          `xml:"localWorkspaceIncludesPrefix"`
+	ShowCreatedTimeColumnsInAdminList  bool          `xml:"showCreatedTimeColumnsInAdminList"`
+	ShowModifiedTimeColumnsInAdminList bool          `xml:"showModifiedTimeColumnsInAdminList"`
+}
+
+// RawSettings keeps a block of settings as is, so that it is sent back
+// untouched on update
+type RawSettings struct {
+	Content string `xml:",innerxml"`
+}
+
+// GlobalSettings are the settings of the instance
+type GlobalSettings struct {
+	XMLName                     xml.Name     `xml:"global"`
+	Settings                    *Settings    `xml:"settings,omitempty"`
+	JAI                         *RawSettings `xml:"jai,omitempty"`
+	CoverageAccess              *RawSettings `xml:"coverageAccess,omitempty"`
+	UpdateSequence              int          `xml:"updateSequence"`
+	FeatureTypeCacheSize        int          `xml:"featureTypeCacheSize"`
+	IsGlobalServices            bool         `xml:"globalServices"`
+	XMLPostRequestLogBufferSize int          `xml:"xmlPostRequestLogBufferSize"`
+	ResourceErrorHandling       string       `xml:"resourceErrorHandling,omitempty"`
+	LockProviderName            string       `xml:"lockProviderName,omitempty"`
+}
+
+// GetSettings returns the global settings
+func (c *Client) GetSettings() (settings *GlobalSettings, err error) {
+	return c.GetSettingsContext(context.Background())
+}
+
+// GetSettingsContext is like GetSettings but carries ctx down to the HTTP requests
+func (c *Client) GetSettingsContext(ctx context.Context) (settings *GlobalSettings, err error) {
+	endpoint := "/settings"
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data GlobalSettings
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return settings, err
+	}
+
+	settings = &data
+
+	return
+}
+
+// UpdateSettings updates the global settings. The settings should be read
+// first with GetSettings, as the blocks left out are reset by GeoServer.
+func (c *Client) UpdateSettings(settings *GlobalSettings) (err error) {
+	return c.UpdateSettingsContext(context.Background(), settings)
+}
+
+// UpdateSettingsContext is like UpdateSettings but carries ctx down to the HTTP requests
+func (c *Client) UpdateSettingsContext(ctx context.Context, settings *GlobalSettings) (err error) {
+	settings.XMLName = xml.Name{
+		Local: "global",
+	}
+	payload, err := xml.Marshal(settings)
+	if err != nil {
+		return
+	}
+
+	endpoint := "/settings"
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 405:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// GetContact returns the global contact information
+func (c *Client) GetContact() (contact *Contact, err error) {
+	return c.GetContactContext(context.Background())
+}
+
+// GetContactContext is like GetContact but carries ctx down to the HTTP requests
+func (c *Client) GetContactContext(ctx context.Context) (contact *Contact, err error) {
+	endpoint := "/settings/contact"
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data Contact
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return contact, err
+	}
+
+	contact = &data
+
+	return
+}
+
+// UpdateContact updates the global contact information
+func (c *Client) UpdateContact(contact *Contact) (err error) {
+	return c.UpdateContactContext(context.Background(), contact)
+}
+
+// UpdateContactContext is like UpdateContact but carries ctx down to the HTTP requests
+func (c *Client) UpdateContactContext(ctx context.Context, contact *Contact) (err error) {
+	contact.XMLName = xml.Name{
+		Local: "contact",
+	}
+	payload, err := xml.Marshal(contact)
+	if err != nil {
+		return
+	}
+
+	endpoint := "/settings/contact"
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 405:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// GetWorkspaceSettings returns the settings local to a workspace
+func (c *Client) GetWorkspaceSettings(workspace string) (settings *Settings, err error) {
+	return c.GetWorkspaceSettingsContext(context.Background(), workspace)
+}
+
+// GetWorkspaceSettingsContext is like GetWorkspaceSettings but carries ctx down to the HTTP requests
+func (c *Client) GetWorkspaceSettingsContext(ctx context.Context, workspace string) (settings *Settings, err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/settings", workspace)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data Settings
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return settings, err
+	}
+
+	settings = &data
+
+	return
+}
+
+// UpdateWorkspaceSettings sets the settings local to a workspace, creating
+// them when the workspace has none yet
+func (c *Client) UpdateWorkspaceSettings(workspace string, settings *Settings) (err error) {
+	return c.UpdateWorkspaceSettingsContext(context.Background(), workspace, settings)
+}
+
+// UpdateWorkspaceSettingsContext is like UpdateWorkspaceSettings but carries ctx down to the HTTP requests
+func (c *Client) UpdateWorkspaceSettingsContext(ctx context.Context, workspace string, settings *Settings) (err error) {
+	settings.XMLName = xml.Name{
+		Local: "settings",
+	}
+	payload, err := xml.Marshal(settings)
+	if err != nil {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/workspaces/%s/settings", workspace)
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200, 201:
+		return
+	default:
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// DeleteWorkspaceSettings removes the settings local to a workspace, which
+// then falls back to the global settings
+func (c *Client) DeleteWorkspaceSettings(workspace string) (err error) {
+	return c.DeleteWorkspaceSettingsContext(context.Background(), workspace)
+}
+
+// DeleteWorkspaceSettingsContext is like DeleteWorkspaceSettings but carries ctx down to the HTTP requests
+func (c *Client) DeleteWorkspaceSettingsContext(ctx context.Context, workspace string) (err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/settings", workspace)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
+		return
+	}
+}
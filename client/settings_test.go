@@ -0,0 +1,206 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const globalSettingsXML = `<global><settings><id>SettingsInfo.-1</id><contact><contactPerson>Claudius Ptolomaeus</contactPerson></contact><charset>UTF-8</charset><numDecimals>8</numDecimals><onlineResource>http://geoserver.org</onlineResource><proxyBaseUrl>https://maps.example.com/geoserver</proxyBaseUrl><useHeadersProxyURL>false</useHeadersProxyURL><verbose>false</verbose><verboseExceptions>true</verboseExceptions><localWorkspaceIncludesPrefix>false</localWorkspaceIncludesPrefix><showCreatedTimeColumnsInAdminList>false</showCreatedTimeColumnsInAdminList><showModifiedTimeColumnsInAdminList>false</showModifiedTimeColumnsInAdminList></settings><jai><allowInterpolation>false</allowInterpolation><tileThreads>7</tileThreads></jai><coverageAccess><maxPoolSize>10</maxPoolSize></coverageAccess><updateSequence>42</updateSequence><featureTypeCacheSize>0</featureTypeCacheSize><globalServices>true</globalServices><xmlPostRequestLogBufferSize>1024</xmlPostRequestLogBufferSize></global>`
+
+func TestGetSettingsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/settings")
+
+		w.WriteHeader(200)
+		w.Write([]byte(globalSettingsXML))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	settings, err := cli.GetSettings()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "https://maps.example.com/geoserver", settings.Settings.ProxyBaseURL)
+	assert.Equal(t, 8, settings.Settings.NumDecimals)
+	assert.True(t, settings.Settings.IsVerboseExceptions)
+	assert.Equal(t, "Claudius Ptolomaeus", settings.Settings.Contact.ContactPerson)
+	assert.Equal(t, 42, settings.UpdateSequence)
+	assert.True(t, settings.IsGlobalServices)
+}
+
+func TestUpdateSettingsKeepsRawBlocks(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			w.WriteHeader(200)
+			w.Write([]byte(globalSettingsXML))
+			return
+		}
+
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/settings")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Contains(t, string(rawBody), `<proxyBaseUrl>https://other.example.com/geoserver</proxyBaseUrl>`)
+		assert.Contains(t, string(rawBody), `<jai><allowInterpolation>false</allowInterpolation><tileThreads>7</tileThreads></jai>`)
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	settings, err := cli.GetSettings()
+	assert.Nil(t, err)
+
+	settings.Settings.ProxyBaseURL = "https://other.example.com/geoserver"
+	err = cli.UpdateSettings(settings)
+
+	assert.Nil(t, err)
+}
+
+func TestGetContactSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/settings/contact")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<contact>
+			<addressCity>Alexandria</addressCity>
+			<addressCountry>Egypt</addressCountry>
+			<contactEmail>claudius.ptolomaeus@gmail.com</contactEmail>
+			<contactOrganization>The Ancient Geographers</contactOrganization>
+		</contact>
+		`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	contact, err := cli.GetContact()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Alexandria", contact.AddressCity)
+	assert.Equal(t, "claudius.ptolomaeus@gmail.com", contact.ContactEmail)
+}
+
+func TestUpdateContactSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/settings/contact")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `<contact><contactEmail>gis@example.com</contactEmail><contactPerson>GIS team</contactPerson></contact>`, string(rawBody))
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateContact(&Contact{
+		ContactEmail:  "gis@example.com",
+		ContactPerson: "GIS team",
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestGetWorkspaceSettingsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/workspaces/topp/settings")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<settings>
+			<workspace>
+				<name>topp</name>
+			</workspace>
+			<charset>ISO-8859-1</charset>
+			<numDecimals>4</numDecimals>
+			<verbose>true</verbose>
+		</settings>
+		`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	settings, err := cli.GetWorkspaceSettings("topp")
+
+	assert.Nil(t, err)
+	assert.Equal(t, &WorkspaceRef{Name: "topp"}, settings.Workspace)
+	assert.Equal(t, "ISO-8859-1", settings.Charset)
+	assert.Equal(t, 4, settings.NumDecimals)
+	assert.True(t, settings.IsVerbose)
+}
+
+func TestUpdateWorkspaceSettingsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/workspaces/topp/settings")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Contains(t, string(rawBody), `<settings><charset>UTF-8</charset><numDecimals>6</numDecimals>`)
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UpdateWorkspaceSettings("topp", &Settings{
+		Charset:     "UTF-8",
+		NumDecimals: 6,
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteWorkspaceSettingsNotFound(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.Path, "/workspaces/topp/settings")
+
+		w.WriteHeader(404)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteWorkspaceSettings("topp")
+
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 	"strings"
@@ -49,6 +50,28 @@ func (c *Client) GetHTTPContentTypeFor(format string, version string) (contentTy
 
 // GetStyles returns all the styles
 func (c *Client) GetStyles(workspace string) (styles []*Style, err error) {
+	return c.GetStylesContext(context.Background(), workspace)
+}
+
+// GetStylesContext is like GetStyles but carries ctx down to the HTTP requests
+func (c *Client) GetStylesContext(ctx context.Context, workspace string) (styles []*Style, err error) {
+	names, err := c.GetStyleNamesContext(ctx, workspace)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*Style, error) {
+		return c.GetStyleContext(ctx, workspace, name)
+	})
+}
+
+// GetStyleNames returns the names of the styles, without fetching them
+func (c *Client) GetStyleNames(workspace string) (names []string, err error) {
+	return c.GetStyleNamesContext(context.Background(), workspace)
+}
+
+// GetStyleNamesContext is like GetStyleNames but carries ctx down to the HTTP requests
+func (c *Client) GetStyleNamesContext(ctx context.Context, workspace string) (names []string, err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -57,35 +80,30 @@ func (c *Client) GetStyles(workspace string) (styles []*Style, err error) {
 		endpoint = fmt.Sprintf("/workspaces/%s/styles", workspace)
 	}
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
 	var data Styles
 
 	if err := xml.Unmarshal([]byte(body), &data); err != nil {
-		return styles, err
+		return names, err
 	}
 
 	for _, styleRef := range data.List {
-		style, err := c.GetStyle(workspace, styleRef.Name)
-		if err != nil {
-			return styles, err
-		}
-
-		styles = append(styles, style)
+		names = append(names, styleRef.Name)
 	}
 
 	return
@@ -93,6 +111,11 @@ func (c *Client) GetStyles(workspace string) (styles []*Style, err error) {
 
 // GetStyle return a single style based on its name
 func (c *Client) GetStyle(workspace, name string) (style *Style, err error) {
+	return c.GetStyleContext(context.Background(), workspace, name)
+}
+
+// GetStyleContext is like GetStyle but carries ctx down to the HTTP requests
+func (c *Client) GetStyleContext(ctx context.Context, workspace, name string) (style *Style, err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -101,22 +124,22 @@ func (c *Client) GetStyle(workspace, name string) (style *Style, err error) {
 		endpoint = fmt.Sprintf("/workspaces/%s/styles/%s", workspace, name)
 	}
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -132,6 +155,11 @@ func (c *Client) GetStyle(workspace, name string) (style *Style, err error) {
 
 // GetStyleFile retrieves the style definition of a given format
 func (c *Client) GetStyleFile(workspace, name string, styleFormat string, formatVersion string) (styleFile string, err error) {
+	return c.GetStyleFileContext(context.Background(), workspace, name, styleFormat, formatVersion)
+}
+
+// GetStyleFileContext is like GetStyleFile but carries ctx down to the HTTP requests
+func (c *Client) GetStyleFileContext(ctx context.Context, workspace, name string, styleFormat string, formatVersion string) (styleFile string, err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -143,22 +171,22 @@ func (c *Client) GetStyleFile(workspace, name string, styleFormat string, format
 	// Try to retrieve the style file based on the style format
 	contentType := c.GetHTTPContentTypeFor(styleFormat, formatVersion)
 
-	statusCode, styleFile, err := c.doTypedRequest("GET", endpoint, nil, contentType)
+	statusCode, styleFile, err := c.doTypedRequest(ctx, "GET", endpoint, nil, contentType)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, styleFile, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, styleFile, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, styleFile)
+		err = newAPIError("GET", endpoint, statusCode, styleFile, nil)
 		return
 	}
 
@@ -167,6 +195,11 @@ func (c *Client) GetStyleFile(workspace, name string, styleFormat string, format
 
 // CreateStyle creates a style
 func (c *Client) CreateStyle(workspace string, style *Style) (err error) {
+	return c.CreateStyleContext(context.Background(), workspace, style)
+}
+
+// CreateStyleContext is like CreateStyle but carries ctx down to the HTTP requests
+func (c *Client) CreateStyleContext(ctx context.Context, workspace string, style *Style) (err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -184,7 +217,7 @@ func (c *Client) CreateStyle(workspace string, style *Style) (err error) {
 	if err != nil {
 		return
 	}
-	statusCode, body, err := c.doFullyTypedRequest("POST", endpoint, bytes.NewBuffer(payload), "application/xml", "")
+	statusCode, body, err := c.doFullyTypedRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload), "application/xml", "")
 
 	if err != nil {
 		return
@@ -192,18 +225,23 @@ func (c *Client) CreateStyle(workspace string, style *Style) (err error) {
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s - %s", statusCode, body, string(payload))
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateStyle creates a style
 func (c *Client) UpdateStyle(workspace string, style *Style, styleDefinition string) (err error) {
+	return c.UpdateStyleContext(context.Background(), workspace, style, styleDefinition)
+}
+
+// UpdateStyleContext is like UpdateStyle but carries ctx down to the HTTP requests
+func (c *Client) UpdateStyleContext(ctx context.Context, workspace string, style *Style, styleDefinition string) (err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -216,25 +254,30 @@ func (c *Client) UpdateStyle(workspace string, style *Style, styleDefinition str
 
 	contentType := c.GetHTTPContentTypeFor(style.Format, style.Version.Version)
 
-	statusCode, body, err := c.doFullyTypedRequest("POST", endpoint, strings.NewReader(styleDefinition), contentType, "")
+	statusCode, body, err := c.doFullyTypedRequest(ctx, "POST", endpoint, strings.NewReader(styleDefinition), contentType, "")
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s - %s", statusCode, body, styleDefinition)
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateStyleContent changes the style definition
 func (c *Client) UpdateStyleContent(workspace string, style *Style, styleDefinition string) (err error) {
+	return c.UpdateStyleContentContext(context.Background(), workspace, style, styleDefinition)
+}
+
+// UpdateStyleContentContext is like UpdateStyleContent but carries ctx down to the HTTP requests
+func (c *Client) UpdateStyleContentContext(ctx context.Context, workspace string, style *Style, styleDefinition string) (err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -247,25 +290,30 @@ func (c *Client) UpdateStyleContent(workspace string, style *Style, styleDefinit
 
 	contentType := c.GetHTTPContentTypeFor(style.Format, style.Version.Version)
 
-	statusCode, body, err := c.doFullyTypedRequest("PUT", endpoint, strings.NewReader(styleDefinition), contentType, "")
+	statusCode, body, err := c.doFullyTypedRequest(ctx, "PUT", endpoint, strings.NewReader(styleDefinition), contentType, "")
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s - %s", statusCode, body, styleDefinition)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteStyle deletes style from GeoServer
 func (c *Client) DeleteStyle(workspace string, style string, purge bool, recurse bool) (err error) {
+	return c.DeleteStyleContext(context.Background(), workspace, style, purge, recurse)
+}
+
+// DeleteStyleContext is like DeleteStyle but carries ctx down to the HTTP requests
+func (c *Client) DeleteStyleContext(ctx context.Context, workspace string, style string, purge bool, recurse bool) (err error) {
 	var endpoint string
 
 	if workspace == "" {
@@ -274,28 +322,28 @@ func (c *Client) DeleteStyle(workspace string, style string, purge bool, recurse
 		endpoint = fmt.Sprintf("/workspaces/%s/styles/%s?purge=%t&recurse=%t", workspace, style, purge, recurse)
 	}
 
-	statusCode, body, err := c.doRequest("DELETE", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// UploadMethod tells how the data of an upload reaches GeoServer
+type UploadMethod string
+
+const (
+	// UploadFile sends the data in the request body
+	UploadFile UploadMethod = "file"
+	// UploadURL sends a URL GeoServer downloads the data from
+	UploadURL UploadMethod = "url"
+	// UploadExternal sends the path of the data on the GeoServer host
+	UploadExternal UploadMethod = "external"
+)
+
+// UploadOptions are the parameters of an upload to a store
+type UploadOptions struct {
+	// Method defaults to UploadFile
+	Method UploadMethod
+	// Configure tells which resources to publish: "first", "none" or "all"
+	Configure string
+	// Update tells what to do with existing data: "append" or "overwrite"
+	Update string
+	// Charset is the character set of the data, e.g. of Shapefile attributes
+	Charset string
+	// Filename is the name given to the uploaded file when it is not an
+	// archive
+	Filename string
+	// ContentType overrides the content type guessed from the format
+	ContentType string
+}
+
+// uploadContentTypes are the content types of the uploaded data, by format
+var uploadContentTypes = map[string]string{
+	"shp":         "application/zip",
+	"appschema":   "application/zip",
+	"imagemosaic": "application/zip",
+	"worldimage":  "application/zip",
+	"gpkg":        "application/geopackage+sqlite3",
+	"geotiff":     "image/tiff",
+	"properties":  "text/plain",
+	"arcgrid":     "text/plain",
+}
+
+// uploadContentType chooses the content type of an upload
+func uploadContentType(format string, options *UploadOptions) string {
+	if options.ContentType != "" {
+		return options.ContentType
+	}
+
+	if options.Method == UploadURL || options.Method == UploadExternal {
+		return "text/plain"
+	}
+
+	if contentType, ok := uploadContentTypes[strings.ToLower(format)]; ok {
+		return contentType
+	}
+
+	return "application/octet-stream"
+}
+
+// uploadEndpoint builds the endpoint uploading to the given store
+func uploadEndpoint(storePath, format string, options *UploadOptions) string {
+	method := options.Method
+	if method == "" {
+		method = UploadFile
+	}
+
+	params := url.Values{}
+	if options.Configure != "" {
+		params.Set("configure", options.Configure)
+	}
+	if options.Update != "" {
+		params.Set("update", options.Update)
+	}
+	if options.Charset != "" {
+		params.Set("charset", options.Charset)
+	}
+	if options.Filename != "" {
+		params.Set("filename", options.Filename)
+	}
+
+	endpoint := fmt.Sprintf("%s/%s.%s", storePath, method, format)
+	if len(params) > 0 {
+		endpoint = endpoint + "?" + params.Encode()
+	}
+
+	return endpoint
+}
+
+// upload streams data to a store with the given HTTP method, PUT creating
+// the store when it does not exist
+func (c *Client) upload(ctx context.Context, method, storePath, format string, data io.Reader, options *UploadOptions) (err error) {
+	if options == nil {
+		options = &UploadOptions{}
+	}
+
+	endpoint := uploadEndpoint(storePath, format, options)
+	statusCode, body, err := c.doFullyTypedRequest(ctx, method, endpoint, data, uploadContentType(format, options), "application/xml")
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError(method, endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError(method, endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError(method, endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200, 201, 202:
+		return
+	default:
+		err = newAPIError(method, endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// uploadPath uploads the file at path. The file is sent as a seekable body
+// so that it can be sent again when the call is retried.
+func (c *Client) uploadPath(ctx context.Context, method, storePath, format, path string, options *UploadOptions) (err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return
+	}
+
+	if options == nil {
+		options = &UploadOptions{}
+	}
+	if options.ContentType == "" && strings.EqualFold(filepath.Ext(path), ".zip") {
+		withZip := *options
+		withZip.ContentType = "application/zip"
+		options = &withZip
+	}
+
+	return c.upload(ctx, method, storePath, format, io.NewSectionReader(file, 0, info.Size()), options)
+}
+
+// UploadDatastore creates or updates a datastore from data in the given
+// format, e.g. "shp" for a zipped Shapefile or "gpkg" for a GeoPackage
+func (c *Client) UploadDatastore(workspace, datastore, format string, data io.Reader, options *UploadOptions) (err error) {
+	return c.UploadDatastoreContext(context.Background(), workspace, datastore, format, data, options)
+}
+
+// UploadDatastoreContext is like UploadDatastore but carries ctx down to the HTTP requests
+func (c *Client) UploadDatastoreContext(ctx context.Context, workspace, datastore, format string, data io.Reader, options *UploadOptions) (err error) {
+	return c.upload(ctx, "PUT", fmt.Sprintf("/workspaces/%s/datastores/%s", workspace, datastore), format, data, options)
+}
+
+// UploadDatastoreFile is like UploadDatastore but streams the file at path
+func (c *Client) UploadDatastoreFile(workspace, datastore, format, path string, options *UploadOptions) (err error) {
+	return c.UploadDatastoreFileContext(context.Background(), workspace, datastore, format, path, options)
+}
+
+// UploadDatastoreFileContext is like UploadDatastoreFile but carries ctx down to the HTTP requests
+func (c *Client) UploadDatastoreFileContext(ctx context.Context, workspace, datastore, format, path string, options *UploadOptions) (err error) {
+	return c.uploadPath(ctx, "PUT", fmt.Sprintf("/workspaces/%s/datastores/%s", workspace, datastore), format, path, options)
+}
+
+// UploadCoverageStore creates or updates a coverage store from data in the
+// given format, e.g. "geotiff" or "imagemosaic" for a zipped mosaic
+func (c *Client) UploadCoverageStore(workspace, coverageStore, format string, data io.Reader, options *UploadOptions) (err error) {
+	return c.UploadCoverageStoreContext(context.Background(), workspace, coverageStore, format, data, options)
+}
+
+// UploadCoverageStoreContext is like UploadCoverageStore but carries ctx down to the HTTP requests
+func (c *Client) UploadCoverageStoreContext(ctx context.Context, workspace, coverageStore, format string, data io.Reader, options *UploadOptions) (err error) {
+	return c.upload(ctx, "PUT", fmt.Sprintf("/workspaces/%s/coveragestores/%s", workspace, coverageStore), format, data, options)
+}
+
+// UploadCoverageStoreFile is like UploadCoverageStore but streams the file at path
+func (c *Client) UploadCoverageStoreFile(workspace, coverageStore, format, path string, options *UploadOptions) (err error) {
+	return c.UploadCoverageStoreFileContext(context.Background(), workspace, coverageStore, format, path, options)
+}
+
+// UploadCoverageStoreFileContext is like UploadCoverageStoreFile but carries ctx down to the HTTP requests
+func (c *Client) UploadCoverageStoreFileContext(ctx context.Context, workspace, coverageStore, format, path string, options *UploadOptions) (err error) {
+	return c.uploadPath(ctx, "PUT", fmt.Sprintf("/workspaces/%s/coveragestores/%s", workspace, coverageStore), format, path, options)
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadDatastoreSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/workspaces/topp/datastores/roads/file.shp")
+		assert.Equal(t, r.URL.Query().Get("configure"), "all")
+		assert.Equal(t, r.URL.Query().Get("update"), "overwrite")
+		assert.Equal(t, r.URL.Query().Get("charset"), "ISO-8859-1")
+		assert.Equal(t, r.Header.Get("Content-Type"), "application/zip")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, "PK\x03\x04zipped shapefile", string(rawBody))
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UploadDatastore("topp", "roads", "shp", bytes.NewReader([]byte("PK\x03\x04zipped shapefile")), &UploadOptions{
+		Configure: "all",
+		Update:    "overwrite",
+		Charset:   "ISO-8859-1",
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestUploadDatastoreExternal(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/workspaces/topp/datastores/roads/external.gpkg")
+		assert.Equal(t, r.URL.RawQuery, "")
+		assert.Equal(t, r.Header.Get("Content-Type"), "text/plain")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, "file:/data/roads.gpkg", string(rawBody))
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UploadDatastore("topp", "roads", "gpkg", strings.NewReader("file:/data/roads.gpkg"), &UploadOptions{
+		Method: UploadExternal,
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestUploadCoverageStoreFileRetried(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dem.tif")
+	assert.Nil(t, os.WriteFile(path, []byte("II*\x00tiff data"), 0600))
+
+	attempts := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/workspaces/nurc/coveragestores/dem/file.geotiff")
+		assert.Equal(t, r.URL.Query().Get("filename"), "dem.tif")
+		assert.Equal(t, r.Header.Get("Content-Type"), "image/tiff")
+
+		rawBody, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, "II*\x00tiff data", string(rawBody))
+
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(503)
+			return
+		}
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:         testServer.URL,
+		HTTPClient:  &http.Client{},
+		RetryPolicy: testBackoffPolicy(),
+	}
+
+	err := cli.UploadCoverageStoreFile("nurc", "dem", "geotiff", path, &UploadOptions{
+		Filename: "dem.tif",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestUploadCoverageStoreFileZip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mosaic.zip")
+	assert.Nil(t, os.WriteFile(path, []byte("PK\x03\x04"), 0600))
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/workspaces/nurc/coveragestores/mosaic/file.geotiff")
+		assert.Equal(t, r.Header.Get("Content-Type"), "application/zip")
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UploadCoverageStoreFile("nurc", "mosaic", "geotiff", path, nil)
+
+	assert.Nil(t, err)
+}
+
+func TestUploadCoverageStoreNotFound(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		w.Write([]byte(`No such workspace: nurc`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UploadCoverageStore("nurc", "dem", "geotiff", strings.NewReader(""), nil)
+
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestUploadFileMissing(t *testing.T) {
+	cli := &Client{
+		URL:        "http://localhost:0",
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.UploadDatastoreFile("topp", "roads", "shp", filepath.Join(t.TempDir(), "missing.zip"), nil)
+
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
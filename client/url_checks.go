@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -29,37 +30,54 @@ type RegexUrlCheck struct {
 
 // GetUrlChecks returns all the URL checks
 func (c *Client) GetUrlChecks() (urlChecks []*RegexUrlCheck, err error) {
+	return c.GetUrlChecksContext(context.Background())
+}
+
+// GetUrlChecksContext is like GetUrlChecks but carries ctx down to the HTTP requests
+func (c *Client) GetUrlChecksContext(ctx context.Context) (urlChecks []*RegexUrlCheck, err error) {
+	names, err := c.GetUrlCheckNamesContext(ctx)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*RegexUrlCheck, error) {
+		return c.GetRegExUrlCheckContext(ctx, name)
+	})
+}
+
+// GetUrlCheckNames returns the names of the URL checks, without fetching them
+func (c *Client) GetUrlCheckNames() (names []string, err error) {
+	return c.GetUrlCheckNamesContext(context.Background())
+}
+
+// GetUrlCheckNamesContext is like GetUrlCheckNames but carries ctx down to the HTTP requests
+func (c *Client) GetUrlCheckNamesContext(ctx context.Context) (names []string, err error) {
 	var endpoint string = "/urlchecks"
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
 	var data UrlChecks
 
 	if err := xml.Unmarshal([]byte(body), &data); err != nil {
-		return urlChecks, err
+		return names, err
 	}
 
 	for _, urlCheckRef := range data.List {
-		urlCheck, err := c.GetRegExUrlCheck(urlCheckRef.Name)
-		if err != nil {
-			return urlChecks, err
-		}
-
-		urlChecks = append(urlChecks, urlCheck)
+		names = append(names, urlCheckRef.Name)
 	}
 
 	return
@@ -67,22 +85,27 @@ func (c *Client) GetUrlChecks() (urlChecks []*RegexUrlCheck, err error) {
 
 // GetRegExUrlCheck returns the definition of a RegEx based URL check
 func (c *Client) GetRegExUrlCheck(urlCheckName string) (regExUrlCheck *RegexUrlCheck, err error) {
+	return c.GetRegExUrlCheckContext(context.Background(), urlCheckName)
+}
+
+// GetRegExUrlCheckContext is like GetRegExUrlCheck but carries ctx down to the HTTP requests
+func (c *Client) GetRegExUrlCheckContext(ctx context.Context, urlCheckName string) (regExUrlCheck *RegexUrlCheck, err error) {
 
 	var endpoint string = fmt.Sprintf("/urlchecks/%s", urlCheckName)
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 404:
-		err = fmt.Errorf("URL checks not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -98,6 +121,11 @@ func (c *Client) GetRegExUrlCheck(urlCheckName string) (regExUrlCheck *RegexUrlC
 
 // CreateRegExUrlCheck creates a new URl checks on GeoServer
 func (c *Client) CreateRegExUrlCheck(checkName string, checkDefinition *RegexUrlCheck) (err error) {
+	return c.CreateRegExUrlCheckContext(context.Background(), checkName, checkDefinition)
+}
+
+// CreateRegExUrlCheckContext is like CreateRegExUrlCheck but carries ctx down to the HTTP requests
+func (c *Client) CreateRegExUrlCheckContext(ctx context.Context, checkName string, checkDefinition *RegexUrlCheck) (err error) {
 	var endpoint string = "/urlchecks"
 
 	checkDefinition.XMLName = xml.Name{
@@ -107,25 +135,30 @@ func (c *Client) CreateRegExUrlCheck(checkName string, checkDefinition *RegexUrl
 	if err != nil {
 		return
 	}
-	statusCode, body, err := c.doRequest("POST", endpoint, bytes.NewBuffer(payload))
+	statusCode, body, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s - %s", statusCode, body, string(payload))
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateRegExUrlCheck updates an existing URL Check based on a regexp
 func (c *Client) UpdateRegExUrlCheck(checkName string, checkDefinition *RegexUrlCheck) (err error) {
+	return c.UpdateRegExUrlCheckContext(context.Background(), checkName, checkDefinition)
+}
+
+// UpdateRegExUrlCheckContext is like UpdateRegExUrlCheck but carries ctx down to the HTTP requests
+func (c *Client) UpdateRegExUrlCheckContext(ctx context.Context, checkName string, checkDefinition *RegexUrlCheck) (err error) {
 	var endpoint string = fmt.Sprintf("/urlchecks/%s", checkName)
 
 	checkDefinition.XMLName = xml.Name{
@@ -133,52 +166,57 @@ func (c *Client) UpdateRegExUrlCheck(checkName string, checkDefinition *RegexUrl
 	}
 	payload, _ := xml.Marshal(checkDefinition)
 
-	statusCode, body, err := c.doRequest("PUT", endpoint, bytes.NewBuffer(payload))
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteUrlCheck deletes a url check from GeoServer
 func (c *Client) DeleteUrlCheck(checkName string) (err error) {
+	return c.DeleteUrlCheckContext(context.Background(), checkName)
+}
+
+// DeleteUrlCheckContext is like DeleteUrlCheck but carries ctx down to the HTTP requests
+func (c *Client) DeleteUrlCheckContext(ctx context.Context, checkName string) (err error) {
 	var endpoint string = fmt.Sprintf("/urlchecks/%s", checkName)
 
-	statusCode, body, err := c.doRequest("DELETE", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
@@ -0,0 +1,230 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"slices"
+)
+
+// UserGroups is a list of group names
+type UserGroups struct {
+	XMLName xml.Name `xml:"groups"`
+	List    []string `xml:"group"`
+}
+
+// usergroupEndpoint returns the endpoint of path within a user/group
+// service, the default one when service is empty
+func usergroupEndpoint(service, path string) string {
+	if service == "" {
+		return "/security/usergroup" + path
+	}
+
+	return fmt.Sprintf("/security/usergroup/service/%s%s", service, path)
+}
+
+// GetUserGroups returns the names of the groups of a user/group service
+func (c *Client) GetUserGroups(service string) (groups []string, err error) {
+	return c.GetUserGroupsContext(context.Background(), service)
+}
+
+// GetUserGroupsContext is like GetUserGroups but carries ctx down to the HTTP requests
+func (c *Client) GetUserGroupsContext(ctx context.Context, service string) (groups []string, err error) {
+	return c.getUserGroups(ctx, usergroupEndpoint(service, "/groups"))
+}
+
+// GetUserGroup returns the name of a group, or ErrNotFound when the
+// user/group service has no such group
+func (c *Client) GetUserGroup(service, groupName string) (group string, err error) {
+	return c.GetUserGroupContext(context.Background(), service, groupName)
+}
+
+// GetUserGroupContext is like GetUserGroup but carries ctx down to the HTTP requests
+func (c *Client) GetUserGroupContext(ctx context.Context, service, groupName string) (group string, err error) {
+	groups, err := c.GetUserGroupsContext(ctx, service)
+	if err != nil {
+		return
+	}
+
+	if !slices.Contains(groups, groupName) {
+		return group, fmt.Errorf("group %s: %w", groupName, ErrNotFound)
+	}
+
+	return groupName, nil
+}
+
+// CreateUserGroup creates a group
+func (c *Client) CreateUserGroup(service, groupName string) (err error) {
+	return c.CreateUserGroupContext(context.Background(), service, groupName)
+}
+
+// CreateUserGroupContext is like CreateUserGroup but carries ctx down to the HTTP requests
+func (c *Client) CreateUserGroupContext(ctx context.Context, service, groupName string) (err error) {
+	endpoint := usergroupEndpoint(service, fmt.Sprintf("/group/%s", groupName))
+	statusCode, body, err := c.doRequest(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("POST", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 409:
+		err = newAPIError("POST", endpoint, statusCode, body, ErrConflict)
+		return
+	case 200, 201:
+		return
+	default:
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// DeleteUserGroup deletes a group
+func (c *Client) DeleteUserGroup(service, groupName string) (err error) {
+	return c.DeleteUserGroupContext(context.Background(), service, groupName)
+}
+
+// DeleteUserGroupContext is like DeleteUserGroup but carries ctx down to the HTTP requests
+func (c *Client) DeleteUserGroupContext(ctx context.Context, service, groupName string) (err error) {
+	endpoint := usergroupEndpoint(service, fmt.Sprintf("/group/%s", groupName))
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 405:
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
+		return
+	case 200:
+		return
+	default:
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
+		return
+	}
+}
+
+// GetUserGroupMembers returns the users belonging to a group
+func (c *Client) GetUserGroupMembers(service, groupName string) (users Users, err error) {
+	return c.GetUserGroupMembersContext(context.Background(), service, groupName)
+}
+
+// GetUserGroupMembersContext is like GetUserGroupMembers but carries ctx down to the HTTP requests
+func (c *Client) GetUserGroupMembersContext(ctx context.Context, service, groupName string) (users Users, err error) {
+	endpoint := usergroupEndpoint(service, fmt.Sprintf("/group/%s/users", groupName))
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	err = xml.Unmarshal([]byte(body), &users)
+
+	return
+}
+
+// GetUserMemberships returns the names of the groups a user belongs to
+func (c *Client) GetUserMemberships(service, userName string) (groups []string, err error) {
+	return c.GetUserMembershipsContext(context.Background(), service, userName)
+}
+
+// GetUserMembershipsContext is like GetUserMemberships but carries ctx down to the HTTP requests
+func (c *Client) GetUserMembershipsContext(ctx context.Context, service, userName string) (groups []string, err error) {
+	return c.getUserGroups(ctx, usergroupEndpoint(service, fmt.Sprintf("/user/%s/groups", userName)))
+}
+
+// getUserGroups reads a list of groups
+func (c *Client) getUserGroups(ctx context.Context, endpoint string) (groups []string, err error) {
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200:
+		break
+	default:
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
+		return
+	}
+
+	var data UserGroups
+	if err := xml.Unmarshal([]byte(body), &data); err != nil {
+		return groups, err
+	}
+
+	return data.List, nil
+}
+
+// AddUserToGroup makes a user a member of a group
+func (c *Client) AddUserToGroup(service, userName, groupName string) (err error) {
+	return c.AddUserToGroupContext(context.Background(), service, userName, groupName)
+}
+
+// AddUserToGroupContext is like AddUserToGroup but carries ctx down to the HTTP requests
+func (c *Client) AddUserToGroupContext(ctx context.Context, service, userName, groupName string) (err error) {
+	return c.groupMembership(ctx, "POST", service, userName, groupName)
+}
+
+// RemoveUserFromGroup removes a user from the members of a group
+func (c *Client) RemoveUserFromGroup(service, userName, groupName string) (err error) {
+	return c.RemoveUserFromGroupContext(context.Background(), service, userName, groupName)
+}
+
+// RemoveUserFromGroupContext is like RemoveUserFromGroup but carries ctx down to the HTTP requests
+func (c *Client) RemoveUserFromGroupContext(ctx context.Context, service, userName, groupName string) (err error) {
+	return c.groupMembership(ctx, "DELETE", service, userName, groupName)
+}
+
+// groupMembership adds (POST) or removes (DELETE) a user to or from a group
+func (c *Client) groupMembership(ctx context.Context, method, service, userName, groupName string) (err error) {
+	endpoint := usergroupEndpoint(service, fmt.Sprintf("/user/%s/group/%s", userName, groupName))
+	statusCode, body, err := c.doRequest(ctx, method, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	switch statusCode {
+	case 401:
+		err = newAPIError(method, endpoint, statusCode, body, ErrUnauthorized)
+		return
+	case 404:
+		err = newAPIError(method, endpoint, statusCode, body, ErrNotFound)
+		return
+	case 200, 201:
+		return
+	default:
+		err = newAPIError(method, endpoint, statusCode, body, nil)
+		return
+	}
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetGroupsServiceSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/usergroup/service/partners/groups")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<groups><group>acme</group><group>globex</group></groups>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	groups, err := cli.GetUserGroups("partners")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"acme", "globex"}, groups)
+
+	group, err := cli.GetUserGroup("partners", "globex")
+	assert.Nil(t, err)
+	assert.Equal(t, "globex", group)
+
+	_, err = cli.GetUserGroup("partners", "initech")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestCreateGroupSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/security/usergroup/group/acme")
+
+		w.WriteHeader(201)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.CreateUserGroup("", "acme")
+
+	assert.Nil(t, err)
+}
+
+func TestDeleteGroupNotFound(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "DELETE")
+		assert.Equal(t, r.URL.Path, "/security/usergroup/service/partners/group/acme")
+
+		w.WriteHeader(404)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	err := cli.DeleteUserGroup("partners", "acme")
+
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestGetUserGroupMembersSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/usergroup/service/partners/group/acme/users")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`
+		<users>
+			<user>
+				<userName>wile</userName>
+				<enabled>true</enabled>
+			</user>
+		</users>
+		`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	users, err := cli.GetUserGroupMembers("partners", "acme")
+
+	assert.Nil(t, err)
+	assert.Len(t, users.List, 1)
+	assert.Equal(t, "wile", users.List[0].Name)
+}
+
+func TestGetUserMembershipsSuccess(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/security/usergroup/user/wile/groups")
+
+		w.WriteHeader(200)
+		w.Write([]byte(`<groups><group>acme</group></groups>`))
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	groups, err := cli.GetUserMemberships("", "wile")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"acme"}, groups)
+}
+
+func TestGroupMembership(t *testing.T) {
+	var calls []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		w.WriteHeader(200)
+	}))
+	defer testServer.Close()
+
+	cli := &Client{
+		URL:        testServer.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	assert.Nil(t, cli.AddUserToGroup("partners", "wile", "acme"))
+	assert.Nil(t, cli.RemoveUserFromGroup("partners", "wile", "acme"))
+	assert.Equal(t, []string{
+		"POST /security/usergroup/service/partners/user/wile/group/acme",
+		"DELETE /security/usergroup/service/partners/user/wile/group/acme",
+	}, calls)
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 	"slices"
@@ -23,27 +24,26 @@ type User struct {
 
 // GetUsers returns all the users
 func (c *Client) GetUsers(serviceName string) (users Users, err error) {
-	var endpoint string
+	return c.GetUsersContext(context.Background(), serviceName)
+}
 
-	if serviceName == "" {
-		endpoint = "/usergroup/users"
-	} else {
-		endpoint = fmt.Sprintf("/usergroup/service/%s/users", serviceName)
-	}
+// GetUsersContext is like GetUsers but carries ctx down to the HTTP requests
+func (c *Client) GetUsersContext(ctx context.Context, serviceName string) (users Users, err error) {
+	endpoint := usergroupEndpoint(serviceName, "/users")
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -58,7 +58,12 @@ func (c *Client) GetUsers(serviceName string) (users Users, err error) {
 
 // GetUser return a single user based on its name
 func (c *Client) GetUser(serviceName, userName string) (user *User, err error) {
-	users, usersErr := c.GetUsers(serviceName)
+	return c.GetUserContext(context.Background(), serviceName, userName)
+}
+
+// GetUserContext is like GetUser but carries ctx down to the HTTP requests
+func (c *Client) GetUserContext(ctx context.Context, serviceName, userName string) (user *User, err error) {
+	users, usersErr := c.GetUsersContext(ctx, serviceName)
 
 	if usersErr != nil {
 		return user, usersErr
@@ -67,7 +72,7 @@ func (c *Client) GetUser(serviceName, userName string) (user *User, err error) {
 	userIdx := slices.IndexFunc(users.List, func(user *User) bool { return user.Name == userName })
 
 	if userIdx == -1 {
-		return user, fmt.Errorf("User not found")
+		return user, fmt.Errorf("user %s: %w", userName, ErrNotFound)
 	}
 
 	user = users.List[userIdx]
@@ -76,13 +81,12 @@ func (c *Client) GetUser(serviceName, userName string) (user *User, err error) {
 
 // CreateUser creates a user
 func (c *Client) CreateUser(service string, user *User) (err error) {
-	var endpoint string
+	return c.CreateUserContext(context.Background(), service, user)
+}
 
-	if service == "" {
-		endpoint = "/usergroup/users"
-	} else {
-		endpoint = fmt.Sprintf("/usergroup/service/%s/users", service)
-	}
+// CreateUserContext is like CreateUser but carries ctx down to the HTTP requests
+func (c *Client) CreateUserContext(ctx context.Context, service string, user *User) (err error) {
+	endpoint := usergroupEndpoint(service, "/users")
 
 	user.XMLName = xml.Name{
 		Local: "user",
@@ -91,7 +95,7 @@ func (c *Client) CreateUser(service string, user *User) (err error) {
 	if err != nil {
 		return
 	}
-	statusCode, body, err := c.doFullyTypedRequest("POST", endpoint, bytes.NewBuffer(payload), "application/xml", "")
+	statusCode, body, err := c.doFullyTypedRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload), "application/xml", "")
 
 	if err != nil {
 		return
@@ -99,25 +103,24 @@ func (c *Client) CreateUser(service string, user *User) (err error) {
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s - %s", statusCode, body, string(payload))
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateUser creates a user
 func (c *Client) UpdateUser(service, userName string, user *User) (err error) {
-	var endpoint string
+	return c.UpdateUserContext(context.Background(), service, userName, user)
+}
 
-	if service == "" {
-		endpoint = fmt.Sprintf("/usergroup/user/%s", userName)
-	} else {
-		endpoint = fmt.Sprintf("/usergroup/service/%s/user/%s", service, userName)
-	}
+// UpdateUserContext is like UpdateUser but carries ctx down to the HTTP requests
+func (c *Client) UpdateUserContext(ctx context.Context, service, userName string, user *User) (err error) {
+	endpoint := usergroupEndpoint(service, fmt.Sprintf("/user/%s", userName))
 
 	user.XMLName = xml.Name{
 		Local: "user",
@@ -126,7 +129,7 @@ func (c *Client) UpdateUser(service, userName string, user *User) (err error) {
 	if err != nil {
 		return
 	}
-	statusCode, body, err := c.doFullyTypedRequest("POST", endpoint, bytes.NewBuffer(payload), "application/xml", "")
+	statusCode, body, err := c.doFullyTypedRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload), "application/xml", "")
 
 	if err != nil {
 		return
@@ -134,54 +137,53 @@ func (c *Client) UpdateUser(service, userName string, user *User) (err error) {
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteUser deletes user from GeoServer
 func (c *Client) DeleteUser(service, userName string) (err error) {
-	var endpoint string
+	return c.DeleteUserContext(context.Background(), service, userName)
+}
 
-	if service == "" {
-		endpoint = fmt.Sprintf("/usergroup/user/%s", userName)
-	} else {
-		endpoint = fmt.Sprintf("/usergroup/service/%s/user/%s", service, userName)
-	}
+// DeleteUserContext is like DeleteUser but carries ctx down to the HTTP requests
+func (c *Client) DeleteUserContext(ctx context.Context, service, userName string) (err error) {
+	endpoint := usergroupEndpoint(service, fmt.Sprintf("/user/%s", userName))
 
-	statusCode, body, err := c.doRequest("DELETE", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("service name is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
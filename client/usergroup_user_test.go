@@ -12,7 +12,7 @@ import (
 
 func TestGetUsersNoServiceSuccess(t *testing.T) {
 	mux := http.NewServeMux()
-	mux.HandleFunc("/usergroup/users", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/security/usergroup/users", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "GET")
 
 		w.WriteHeader(200)
@@ -57,7 +57,7 @@ func TestGetUsersNoServiceSuccess(t *testing.T) {
 
 func TestGetUsersServiceSuccess(t *testing.T) {
 	mux := http.NewServeMux()
-	mux.HandleFunc("/usergroup/service/foo/users", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/security/usergroup/service/foo/users", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "GET")
 
 		w.WriteHeader(200)
@@ -102,7 +102,7 @@ func TestGetUsersServiceSuccess(t *testing.T) {
 
 func TestGetUserNoServiceSuccess(t *testing.T) {
 	mux := http.NewServeMux()
-	mux.HandleFunc("/usergroup/users", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/security/usergroup/users", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "GET")
 
 		w.WriteHeader(200)
@@ -144,7 +144,7 @@ func TestGetUserNoServiceSuccess(t *testing.T) {
 
 func TestGetUserServiceSuccess(t *testing.T) {
 	mux := http.NewServeMux()
-	mux.HandleFunc("/usergroup/service/foo/users", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/security/usergroup/service/foo/users", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "GET")
 
 		w.WriteHeader(200)
@@ -187,7 +187,7 @@ func TestGetUserServiceSuccess(t *testing.T) {
 func TestGetUserUnauthorized(t *testing.T) {
 	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "GET")
-		assert.Equal(t, r.URL.Path, "/usergroup/users")
+		assert.Equal(t, r.URL.Path, "/security/usergroup/users")
 
 		w.WriteHeader(401)
 		w.Write([]byte(``))
@@ -208,7 +208,7 @@ func TestGetUserUnauthorized(t *testing.T) {
 func TestGetUserNotFound(t *testing.T) {
 	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "GET")
-		assert.Equal(t, r.URL.Path, "/usergroup/users")
+		assert.Equal(t, r.URL.Path, "/security/usergroup/users")
 
 		w.WriteHeader(404)
 		w.Write([]byte(``))
@@ -229,7 +229,7 @@ func TestGetUserNotFound(t *testing.T) {
 func TestGetUserUnknownError(t *testing.T) {
 	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "GET")
-		assert.Equal(t, r.URL.Path, "/usergroup/users")
+		assert.Equal(t, r.URL.Path, "/security/usergroup/users")
 
 		w.WriteHeader(418)
 		w.Write([]byte(`I'm a teapot!`))
@@ -250,7 +250,7 @@ func TestGetUserUnknownError(t *testing.T) {
 func TestCreateUserNoWorkspaceSuccess(t *testing.T) {
 	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "POST")
-		assert.Equal(t, r.URL.Path, "/usergroup/users")
+		assert.Equal(t, r.URL.Path, "/security/usergroup/users")
 
 		rawBody, err := io.ReadAll(r.Body)
 		assert.Nil(t, err)
@@ -291,7 +291,7 @@ func TestCreateUserNoWorkspaceSuccess(t *testing.T) {
 func TestUpdateUserNoWorkspaceSuccess(t *testing.T) {
 	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "POST")
-		assert.Equal(t, r.URL.Path, "/usergroup/user/admin")
+		assert.Equal(t, r.URL.Path, "/security/usergroup/user/admin")
 
 		rawBody, err := io.ReadAll(r.Body)
 		assert.Nil(t, err)
@@ -332,7 +332,7 @@ func TestUpdateUserNoWorkspaceSuccess(t *testing.T) {
 func TestDeleteUserSuccess(t *testing.T) {
 	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "DELETE")
-		assert.Equal(t, r.URL.Path, "/usergroup/user/admin")
+		assert.Equal(t, r.URL.Path, "/security/usergroup/user/admin")
 
 		w.WriteHeader(200)
 		w.Write([]byte(``))
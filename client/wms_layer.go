@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -51,6 +52,28 @@ type WmsLayerBoundingBox struct {
 
 // GetWmsLayers returns all the wms layers
 func (c *Client) GetWmsLayers(workspace, wmsstore string) (wmsLayers []*WmsLayer, err error) {
+	return c.GetWmsLayersContext(context.Background(), workspace, wmsstore)
+}
+
+// GetWmsLayersContext is like GetWmsLayers but carries ctx down to the HTTP requests
+func (c *Client) GetWmsLayersContext(ctx context.Context, workspace, wmsstore string) (wmsLayers []*WmsLayer, err error) {
+	names, err := c.GetWmsLayerNamesContext(ctx, workspace, wmsstore)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*WmsLayer, error) {
+		return c.GetWmsLayerContext(ctx, workspace, wmsstore, name)
+	})
+}
+
+// GetWmsLayerNames returns the names of the wms layers, without fetching them
+func (c *Client) GetWmsLayerNames(workspace, wmsstore string) (names []string, err error) {
+	return c.GetWmsLayerNamesContext(context.Background(), workspace, wmsstore)
+}
+
+// GetWmsLayerNamesContext is like GetWmsLayerNames but carries ctx down to the HTTP requests
+func (c *Client) GetWmsLayerNamesContext(ctx context.Context, workspace, wmsstore string) (names []string, err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -63,35 +86,30 @@ func (c *Client) GetWmsLayers(workspace, wmsstore string) (wmsLayers []*WmsLayer
 		endpoint = fmt.Sprintf("/workspaces/%s/wmsstores/%s/wmslayers", workspace, wmsstore)
 	}
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
 	var data WmsLayers
 
 	if err := xml.Unmarshal([]byte(body), &data); err != nil {
-		return wmsLayers, err
+		return names, err
 	}
 
 	for _, wmsLayerRef := range data.List {
-		wmsLayer, err := c.GetWmsLayer(workspace, wmsstore, wmsLayerRef.Name)
-		if err != nil {
-			return wmsLayers, err
-		}
-
-		wmsLayers = append(wmsLayers, wmsLayer)
+		names = append(names, wmsLayerRef.Name)
 	}
 
 	return
@@ -99,6 +117,11 @@ func (c *Client) GetWmsLayers(workspace, wmsstore string) (wmsLayers []*WmsLayer
 
 // GetWmsLayer return a single wms layer based on its name
 func (c *Client) GetWmsLayer(workspace, wmsstore, name string) (wmsLayer *WmsLayer, err error) {
+	return c.GetWmsLayerContext(context.Background(), workspace, wmsstore, name)
+}
+
+// GetWmsLayerContext is like GetWmsLayer but carries ctx down to the HTTP requests
+func (c *Client) GetWmsLayerContext(ctx context.Context, workspace, wmsstore, name string) (wmsLayer *WmsLayer, err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -111,22 +134,22 @@ func (c *Client) GetWmsLayer(workspace, wmsstore, name string) (wmsLayer *WmsLay
 		endpoint = fmt.Sprintf("/workspaces/%s/wmsstores/%s/wmslayers/%s", workspace, wmsstore, name)
 	}
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -142,6 +165,11 @@ func (c *Client) GetWmsLayer(workspace, wmsstore, name string) (wmsLayer *WmsLay
 
 // CreateWmsLayer creates a WMS Layer
 func (c *Client) CreateWmsLayer(workspace string, wmsstore string, wmsLayer *WmsLayer) (err error) {
+	return c.CreateWmsLayerContext(context.Background(), workspace, wmsstore, wmsLayer)
+}
+
+// CreateWmsLayerContext is like CreateWmsLayer but carries ctx down to the HTTP requests
+func (c *Client) CreateWmsLayerContext(ctx context.Context, workspace string, wmsstore string, wmsLayer *WmsLayer) (err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -161,25 +189,30 @@ func (c *Client) CreateWmsLayer(workspace string, wmsstore string, wmsLayer *Wms
 	if err != nil {
 		return
 	}
-	statusCode, body, err := c.doRequest("POST", endpoint, bytes.NewBuffer(payload))
+	statusCode, body, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s - %s", statusCode, body, string(payload))
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateWmsLayer updates a wms layer
 func (c *Client) UpdateWmsLayer(workspace, wmsstore, wmsLayerName string, wmsLayer *WmsLayer) (err error) {
+	return c.UpdateWmsLayerContext(context.Background(), workspace, wmsstore, wmsLayerName, wmsLayer)
+}
+
+// UpdateWmsLayerContext is like UpdateWmsLayer but carries ctx down to the HTTP requests
+func (c *Client) UpdateWmsLayerContext(ctx context.Context, workspace, wmsstore, wmsLayerName string, wmsLayer *WmsLayer) (err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -197,31 +230,36 @@ func (c *Client) UpdateWmsLayer(workspace, wmsstore, wmsLayerName string, wmsLay
 	}
 	payload, _ := xml.Marshal(wmsLayer)
 
-	statusCode, body, err := c.doRequest("PUT", endpoint, bytes.NewBuffer(payload))
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteWmsLayer deletes a WMS layer
 func (c *Client) DeleteWmsLayer(workspace, wmsstore, wmsLayerName string, recurse bool) (err error) {
+	return c.DeleteWmsLayerContext(context.Background(), workspace, wmsstore, wmsLayerName, recurse)
+}
+
+// DeleteWmsLayerContext is like DeleteWmsLayer but carries ctx down to the HTTP requests
+func (c *Client) DeleteWmsLayerContext(ctx context.Context, workspace, wmsstore, wmsLayerName string, recurse bool) (err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -233,28 +271,28 @@ func (c *Client) DeleteWmsLayer(workspace, wmsstore, wmsLayerName string, recurs
 	} else {
 		endpoint = fmt.Sprintf("/workspaces/%s/wmsstores/%s/wmslayers/%s?recurse=%t", workspace, wmsstore, wmsLayerName, recurse)
 	}
-	statusCode, body, err := c.doRequest("DELETE", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -30,7 +31,7 @@ type WmsStore struct {
 	MaxConnections             int                 `xml:"maxConnections"`
 	ReadTimeOut                int                 `xml:"readTimeout"`
 	ConnectTimeOut             int                 `xml:"connectTimeout"`
-	Type             	   string              `xml:"type"`
+	Type                       string              `xml:"type"`
 }
 
 // NewWmsStore creates a new WmsStore with default values
@@ -42,34 +43,52 @@ func NewWmsStore() *WmsStore {
 
 // GetWmsStores returns the list of the wms stores
 func (c *Client) GetWmsStores(workspace string) (wmsStores []*WmsStore, err error) {
-	statusCode, body, err := c.doRequest("GET", fmt.Sprintf("/workspaces/%s/wmsstores", workspace), nil)
+	return c.GetWmsStoresContext(context.Background(), workspace)
+}
+
+// GetWmsStoresContext is like GetWmsStores but carries ctx down to the HTTP requests
+func (c *Client) GetWmsStoresContext(ctx context.Context, workspace string) (wmsStores []*WmsStore, err error) {
+	names, err := c.GetWmsStoreNamesContext(ctx, workspace)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*WmsStore, error) {
+		return c.GetWmsStoreContext(ctx, workspace, name)
+	})
+}
+
+// GetWmsStoreNames returns the names of the wms stores, without fetching them
+func (c *Client) GetWmsStoreNames(workspace string) (names []string, err error) {
+	return c.GetWmsStoreNamesContext(context.Background(), workspace)
+}
+
+// GetWmsStoreNamesContext is like GetWmsStoreNames but carries ctx down to the HTTP requests
+func (c *Client) GetWmsStoreNamesContext(ctx context.Context, workspace string) (names []string, err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/wmsstores", workspace)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
 	var data WmsStores
 	if err := xml.Unmarshal([]byte(body), &data); err != nil {
-		return wmsStores, nil
+		return names, nil
 	}
 
 	for _, wmsStoreRef := range data.List {
-		wmsStore, err := c.GetWmsStore(workspace, wmsStoreRef.Name)
-		if err != nil {
-			return wmsStores, err
-		}
-
-		wmsStores = append(wmsStores, wmsStore)
+		names = append(names, wmsStoreRef.Name)
 	}
 
 	return
@@ -77,22 +96,28 @@ func (c *Client) GetWmsStores(workspace string) (wmsStores []*WmsStore, err erro
 
 // GetWmsStore return a single wms store based on its name
 func (c *Client) GetWmsStore(workspace, name string) (wmsStore *WmsStore, err error) {
-	statusCode, body, err := c.doRequest("GET", fmt.Sprintf("/workspaces/%s/wmsstores/%s", workspace, name), nil)
+	return c.GetWmsStoreContext(context.Background(), workspace, name)
+}
+
+// GetWmsStoreContext is like GetWmsStore but carries ctx down to the HTTP requests
+func (c *Client) GetWmsStoreContext(ctx context.Context, workspace, name string) (wmsStore *WmsStore, err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/wmsstores/%s", workspace, name)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -108,75 +133,93 @@ func (c *Client) GetWmsStore(workspace, name string) (wmsStore *WmsStore, err er
 
 // CreateWmStore creates a wms store
 func (c *Client) CreateWmStore(workspace string, wmsStore *WmsStore) (err error) {
+	return c.CreateWmStoreContext(context.Background(), workspace, wmsStore)
+}
+
+// CreateWmStoreContext is like CreateWmStore but carries ctx down to the HTTP requests
+func (c *Client) CreateWmStoreContext(ctx context.Context, workspace string, wmsStore *WmsStore) (err error) {
 	payload, _ := xml.Marshal(&wmsStore)
-	statusCode, body, err := c.doRequest("POST", fmt.Sprintf("/workspaces/%s/wmsstores", workspace), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/workspaces/%s/wmsstores", workspace)
+	statusCode, body, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateWmsStore updates a wms store
 func (c *Client) UpdateWmsStore(workspaceName, wmsStoreName string, wmsStore *WmsStore) (err error) {
+	return c.UpdateWmsStoreContext(context.Background(), workspaceName, wmsStoreName, wmsStore)
+}
+
+// UpdateWmsStoreContext is like UpdateWmsStore but carries ctx down to the HTTP requests
+func (c *Client) UpdateWmsStoreContext(ctx context.Context, workspaceName, wmsStoreName string, wmsStore *WmsStore) (err error) {
 	payload, _ := xml.Marshal(&wmsStore)
 
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/workspaces/%s/wmsstores/%s", workspaceName, wmsStoreName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/workspaces/%s/wmsstores/%s", workspaceName, wmsStoreName)
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteWmsStore deletes a wms store
 func (c *Client) DeleteWmsStore(workspaceName, wmsStoreName string, recurse bool) (err error) {
-	statusCode, body, err := c.doRequest("DELETE", fmt.Sprintf("/workspaces/%s/wmsstores/%s?recurse=%t", workspaceName, wmsStoreName, recurse), nil)
+	return c.DeleteWmsStoreContext(context.Background(), workspaceName, wmsStoreName, recurse)
+}
+
+// DeleteWmsStoreContext is like DeleteWmsStore but carries ctx down to the HTTP requests
+func (c *Client) DeleteWmsStoreContext(ctx context.Context, workspaceName, wmsStoreName string, recurse bool) (err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/wmsstores/%s?recurse=%t", workspaceName, wmsStoreName, recurse)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
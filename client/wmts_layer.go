@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -51,6 +52,28 @@ type WmtsLayerBoundingBox struct {
 
 // GetWmtsLayers returns all the wmts layers
 func (c *Client) GetWmtsLayers(workspace, wmtsstore string) (wmtsLayers []*WmtsLayer, err error) {
+	return c.GetWmtsLayersContext(context.Background(), workspace, wmtsstore)
+}
+
+// GetWmtsLayersContext is like GetWmtsLayers but carries ctx down to the HTTP requests
+func (c *Client) GetWmtsLayersContext(ctx context.Context, workspace, wmtsstore string) (wmtsLayers []*WmtsLayer, err error) {
+	names, err := c.GetWmtsLayerNamesContext(ctx, workspace, wmtsstore)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*WmtsLayer, error) {
+		return c.GetWmtsLayerContext(ctx, workspace, wmtsstore, name)
+	})
+}
+
+// GetWmtsLayerNames returns the names of the wmts layers, without fetching them
+func (c *Client) GetWmtsLayerNames(workspace, wmtsstore string) (names []string, err error) {
+	return c.GetWmtsLayerNamesContext(context.Background(), workspace, wmtsstore)
+}
+
+// GetWmtsLayerNamesContext is like GetWmtsLayerNames but carries ctx down to the HTTP requests
+func (c *Client) GetWmtsLayerNamesContext(ctx context.Context, workspace, wmtsstore string) (names []string, err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -63,35 +86,30 @@ func (c *Client) GetWmtsLayers(workspace, wmtsstore string) (wmtsLayers []*WmtsL
 		endpoint = fmt.Sprintf("/workspaces/%s/wmtsstores/%s/layers", workspace, wmtsstore)
 	}
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
 	var data WmtsLayers
 
 	if err := xml.Unmarshal([]byte(body), &data); err != nil {
-		return wmtsLayers, err
+		return names, err
 	}
 
 	for _, wmtsLayerRef := range data.List {
-		wmtsLayer, err := c.GetWmtsLayer(workspace, wmtsstore, wmtsLayerRef.Name)
-		if err != nil {
-			return wmtsLayers, err
-		}
-
-		wmtsLayers = append(wmtsLayers, wmtsLayer)
+		names = append(names, wmtsLayerRef.Name)
 	}
 
 	return
@@ -99,6 +117,11 @@ func (c *Client) GetWmtsLayers(workspace, wmtsstore string) (wmtsLayers []*WmtsL
 
 // GetWmtsLayer return a single wmts layer based on its name
 func (c *Client) GetWmtsLayer(workspace, wmtsstore, name string) (wmtsLayer *WmtsLayer, err error) {
+	return c.GetWmtsLayerContext(context.Background(), workspace, wmtsstore, name)
+}
+
+// GetWmtsLayerContext is like GetWmtsLayer but carries ctx down to the HTTP requests
+func (c *Client) GetWmtsLayerContext(ctx context.Context, workspace, wmtsstore, name string) (wmtsLayer *WmtsLayer, err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -111,22 +134,22 @@ func (c *Client) GetWmtsLayer(workspace, wmtsstore, name string) (wmtsLayer *Wmt
 		endpoint = fmt.Sprintf("/workspaces/%s/wmtsstores/%s/layers/%s", workspace, wmtsstore, name)
 	}
 
-	statusCode, body, err := c.doRequest("GET", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -142,6 +165,11 @@ func (c *Client) GetWmtsLayer(workspace, wmtsstore, name string) (wmtsLayer *Wmt
 
 // CreateWmtsLayer creates a WMTS Layer
 func (c *Client) CreateWmtsLayer(workspace string, wmtsstore string, wmtsLayer *WmtsLayer) (err error) {
+	return c.CreateWmtsLayerContext(context.Background(), workspace, wmtsstore, wmtsLayer)
+}
+
+// CreateWmtsLayerContext is like CreateWmtsLayer but carries ctx down to the HTTP requests
+func (c *Client) CreateWmtsLayerContext(ctx context.Context, workspace string, wmtsstore string, wmtsLayer *WmtsLayer) (err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -161,25 +189,30 @@ func (c *Client) CreateWmtsLayer(workspace string, wmtsstore string, wmtsLayer *
 	if err != nil {
 		return
 	}
-	statusCode, body, err := c.doRequest("POST", endpoint, bytes.NewBuffer(payload))
+	statusCode, body, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s - %s", statusCode, body, string(payload))
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateWmtsLayer updates a wmts layer
 func (c *Client) UpdateWmtsLayer(workspace, wmtsstore, wmtsLayerName string, wmtsLayer *WmtsLayer) (err error) {
+	return c.UpdateWmtsLayerContext(context.Background(), workspace, wmtsstore, wmtsLayerName, wmtsLayer)
+}
+
+// UpdateWmtsLayerContext is like UpdateWmtsLayer but carries ctx down to the HTTP requests
+func (c *Client) UpdateWmtsLayerContext(ctx context.Context, workspace, wmtsstore, wmtsLayerName string, wmtsLayer *WmtsLayer) (err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -197,31 +230,36 @@ func (c *Client) UpdateWmtsLayer(workspace, wmtsstore, wmtsLayerName string, wmt
 	}
 	payload, _ := xml.Marshal(wmtsLayer)
 
-	statusCode, body, err := c.doRequest("PUT", endpoint, bytes.NewBuffer(payload))
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteWmtsLayer deletes a WMS layer
 func (c *Client) DeleteWmtsLayer(workspace, wmtsstore, wmtsLayerName string, recurse bool) (err error) {
+	return c.DeleteWmtsLayerContext(context.Background(), workspace, wmtsstore, wmtsLayerName, recurse)
+}
+
+// DeleteWmtsLayerContext is like DeleteWmtsLayer but carries ctx down to the HTTP requests
+func (c *Client) DeleteWmtsLayerContext(ctx context.Context, workspace, wmtsstore, wmtsLayerName string, recurse bool) (err error) {
 	var endpoint string
 	if workspace == "" {
 		err = fmt.Errorf("workspace cannot be null")
@@ -233,28 +271,28 @@ func (c *Client) DeleteWmtsLayer(workspace, wmtsstore, wmtsLayerName string, rec
 	} else {
 		endpoint = fmt.Sprintf("/workspaces/%s/wmtsstores/%s/layers/%s?recurse=%t", workspace, wmtsstore, wmtsLayerName, recurse)
 	}
-	statusCode, body, err := c.doRequest("DELETE", endpoint, nil)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
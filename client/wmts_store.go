@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -42,34 +43,52 @@ func NewWmtsStore() *WmtsStore {
 
 // GetWmtsStores returns the list of the wmts stores
 func (c *Client) GetWmtsStores(workspace string) (wmtsStores []*WmtsStore, err error) {
-	statusCode, body, err := c.doRequest("GET", fmt.Sprintf("/workspaces/%s/wmtsstores", workspace), nil)
+	return c.GetWmtsStoresContext(context.Background(), workspace)
+}
+
+// GetWmtsStoresContext is like GetWmtsStores but carries ctx down to the HTTP requests
+func (c *Client) GetWmtsStoresContext(ctx context.Context, workspace string) (wmtsStores []*WmtsStore, err error) {
+	names, err := c.GetWmtsStoreNamesContext(ctx, workspace)
+	if err != nil {
+		return
+	}
+
+	return fetchAll(ctx, c, names, func(ctx context.Context, name string) (*WmtsStore, error) {
+		return c.GetWmtsStoreContext(ctx, workspace, name)
+	})
+}
+
+// GetWmtsStoreNames returns the names of the wmts stores, without fetching them
+func (c *Client) GetWmtsStoreNames(workspace string) (names []string, err error) {
+	return c.GetWmtsStoreNamesContext(context.Background(), workspace)
+}
+
+// GetWmtsStoreNamesContext is like GetWmtsStoreNames but carries ctx down to the HTTP requests
+func (c *Client) GetWmtsStoreNamesContext(ctx context.Context, workspace string) (names []string, err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/wmtsstores", workspace)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
 	var data WmtsStores
 	if err := xml.Unmarshal([]byte(body), &data); err != nil {
-		return wmtsStores, nil
+		return names, nil
 	}
 
 	for _, wmtsStoreRef := range data.List {
-		wmtsStore, err := c.GetWmtsStore(workspace, wmtsStoreRef.Name)
-		if err != nil {
-			return wmtsStores, err
-		}
-
-		wmtsStores = append(wmtsStores, wmtsStore)
+		names = append(names, wmtsStoreRef.Name)
 	}
 
 	return
@@ -77,22 +96,28 @@ func (c *Client) GetWmtsStores(workspace string) (wmtsStores []*WmtsStore, err e
 
 // GetWmtsStore return a single wms store based on its name
 func (c *Client) GetWmtsStore(workspace, name string) (wmtsStore *WmtsStore, err error) {
-	statusCode, body, err := c.doRequest("GET", fmt.Sprintf("/workspaces/%s/wmtsstores/%s", workspace, name), nil)
+	return c.GetWmtsStoreContext(context.Background(), workspace, name)
+}
+
+// GetWmtsStoreContext is like GetWmtsStore but carries ctx down to the HTTP requests
+func (c *Client) GetWmtsStoreContext(ctx context.Context, workspace, name string) (wmtsStore *WmtsStore, err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/wmtsstores/%s", workspace, name)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -108,75 +133,93 @@ func (c *Client) GetWmtsStore(workspace, name string) (wmtsStore *WmtsStore, err
 
 // CreateWmStore creates a wmts store
 func (c *Client) CreateWmtStore(workspace string, wmtsStore *WmtsStore) (err error) {
+	return c.CreateWmtStoreContext(context.Background(), workspace, wmtsStore)
+}
+
+// CreateWmtStoreContext is like CreateWmtStore but carries ctx down to the HTTP requests
+func (c *Client) CreateWmtStoreContext(ctx context.Context, workspace string, wmtsStore *WmtsStore) (err error) {
 	payload, _ := xml.Marshal(&wmtsStore)
-	statusCode, body, err := c.doRequest("POST", fmt.Sprintf("/workspaces/%s/wmtsstores", workspace), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/workspaces/%s/wmtsstores", workspace)
+	statusCode, body, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateWmsStore updates a wms store
 func (c *Client) UpdateWmtsStore(workspaceName, wmtsStoreName string, wmtsStore *WmtsStore) (err error) {
+	return c.UpdateWmtsStoreContext(context.Background(), workspaceName, wmtsStoreName, wmtsStore)
+}
+
+// UpdateWmtsStoreContext is like UpdateWmtsStore but carries ctx down to the HTTP requests
+func (c *Client) UpdateWmtsStoreContext(ctx context.Context, workspaceName, wmtsStoreName string, wmtsStore *WmtsStore) (err error) {
 	payload, _ := xml.Marshal(&wmtsStore)
 
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/workspaces/%s/wmtsstores/%s", workspaceName, wmtsStoreName), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/workspaces/%s/wmtsstores/%s", workspaceName, wmtsStoreName)
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteWmtsStore deletes a wmts store
 func (c *Client) DeleteWmtsStore(workspaceName, wmtsStoreName string, recurse bool) (err error) {
-	statusCode, body, err := c.doRequest("DELETE", fmt.Sprintf("/workspaces/%s/wmtsstores/%s?recurse=%t", workspaceName, wmtsStoreName, recurse), nil)
+	return c.DeleteWmtsStoreContext(context.Background(), workspaceName, wmtsStoreName, recurse)
+}
+
+// DeleteWmtsStoreContext is like DeleteWmtsStore but carries ctx down to the HTTP requests
+func (c *Client) DeleteWmtsStoreContext(ctx context.Context, workspaceName, wmtsStoreName string, recurse bool) (err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/wmtsstores/%s?recurse=%t", workspaceName, wmtsStoreName, recurse)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }
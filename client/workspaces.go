@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -31,19 +32,25 @@ type WorkspaceRef struct {
 
 // GetWorkspaces returns the list of the workspaces
 func (c *Client) GetWorkspaces() (workspaces []*Workspace, err error) {
-	statusCode, body, err := c.doRequest("GET", "/workspaces", nil)
+	return c.GetWorkspacesContext(context.Background())
+}
+
+// GetWorkspacesContext is like GetWorkspaces but carries ctx down to the HTTP requests
+func (c *Client) GetWorkspacesContext(ctx context.Context) (workspaces []*Workspace, err error) {
+	endpoint := "/workspaces"
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -63,22 +70,28 @@ func (c *Client) GetWorkspaces() (workspaces []*Workspace, err error) {
 
 // GetWorkspace return a single workspace based on its name
 func (c *Client) GetWorkspace(name string) (workspace *Workspace, err error) {
-	statusCode, body, err := c.doRequest("GET", fmt.Sprintf("/workspaces/%s", name), nil)
+	return c.GetWorkspaceContext(context.Background(), name)
+}
+
+// GetWorkspaceContext is like GetWorkspace but carries ctx down to the HTTP requests
+func (c *Client) GetWorkspaceContext(ctx context.Context, name string) (workspace *Workspace, err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s", name)
+	statusCode, body, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("GET", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 200:
 		break
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("GET", endpoint, statusCode, body, nil)
 		return
 	}
 
@@ -94,75 +107,93 @@ func (c *Client) GetWorkspace(name string) (workspace *Workspace, err error) {
 
 // CreateWorkspace creates a workspace
 func (c *Client) CreateWorkspace(workspace *Workspace, isDefault bool) (err error) {
+	return c.CreateWorkspaceContext(context.Background(), workspace, isDefault)
+}
+
+// CreateWorkspaceContext is like CreateWorkspace but carries ctx down to the HTTP requests
+func (c *Client) CreateWorkspaceContext(ctx context.Context, workspace *Workspace, isDefault bool) (err error) {
 	payload, _ := xml.Marshal(workspace)
-	statusCode, body, err := c.doRequest("POST", fmt.Sprintf("/workspaces?default=%t", isDefault), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/workspaces?default=%t", isDefault)
+	statusCode, body, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("POST", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 201:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("POST", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // UpdateWorkspace updates a workspace
 func (c *Client) UpdateWorkspace(name string, workspace *Workspace) (err error) {
+	return c.UpdateWorkspaceContext(context.Background(), name, workspace)
+}
+
+// UpdateWorkspaceContext is like UpdateWorkspace but carries ctx down to the HTTP requests
+func (c *Client) UpdateWorkspaceContext(ctx context.Context, name string, workspace *Workspace) (err error) {
 	payload, _ := xml.Marshal(workspace)
 
-	statusCode, body, err := c.doRequest("PUT", fmt.Sprintf("/workspaces/%s", name), bytes.NewBuffer(payload))
+	endpoint := fmt.Sprintf("/workspaces/%s", name)
+	statusCode, body, err := c.doRequest(ctx, "PUT", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("PUT", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("PUT", endpoint, statusCode, body, nil)
 		return
 	}
 }
 
 // DeleteWorkspace deletes a workspace
 func (c *Client) DeleteWorkspace(name string, recurse bool) (err error) {
-	statusCode, body, err := c.doRequest("DELETE", fmt.Sprintf("/workspaces/%s?recurse=%t", name, recurse), nil)
+	return c.DeleteWorkspaceContext(context.Background(), name, recurse)
+}
+
+// DeleteWorkspaceContext is like DeleteWorkspace but carries ctx down to the HTTP requests
+func (c *Client) DeleteWorkspaceContext(ctx context.Context, name string, recurse bool) (err error) {
+	endpoint := fmt.Sprintf("/workspaces/%s?recurse=%t", name, recurse)
+	statusCode, body, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return
 	}
 
 	switch statusCode {
 	case 401:
-		err = fmt.Errorf("unauthorized")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrUnauthorized)
 		return
 	case 403:
-		err = fmt.Errorf("workspace is not empty")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotEmpty)
 		return
 	case 404:
-		err = fmt.Errorf("not found")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrNotFound)
 		return
 	case 405:
-		err = fmt.Errorf("forbidden")
+		err = newAPIError("DELETE", endpoint, statusCode, body, ErrForbidden)
 		return
 	case 200:
 		return
 	default:
-		err = fmt.Errorf("unknown error: %d - %s", statusCode, body)
+		err = newAPIError("DELETE", endpoint, statusCode, body, nil)
 		return
 	}
 }